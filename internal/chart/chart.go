@@ -0,0 +1,112 @@
+package chart
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"google.golang.org/api/slides/v1"
+
+	"google-slide-manager/internal/geometry"
+)
+
+// Service wraps Google Slides service for Google Sheets chart operations.
+type Service struct {
+	slidesService *slides.Service
+}
+
+// NewService creates a new chart service.
+func NewService(ctx context.Context, slidesService *slides.Service) *Service {
+	return &Service{
+		slidesService: slidesService,
+	}
+}
+
+// generateObjectID generates a unique object ID using timestamp.
+func generateObjectID(prefix string) string {
+	return fmt.Sprintf("%s_%d", prefix, time.Now().UnixNano())
+}
+
+// AddSheetsChart embeds a Google Sheets chart on a slide. A linked chart can
+// be refreshed from the spreadsheet later; otherwise it is inserted as a
+// static image.
+func (s *Service) AddSheetsChart(ctx context.Context, presentationID string, slideIndex int, spreadsheetID string, chartID int64, linked bool, box geometry.Box) (string, error) {
+	presentation, err := s.slidesService.Presentations.Get(presentationID).Do()
+	if err != nil {
+		return "", fmt.Errorf("error getting presentation: %w", err)
+	}
+
+	if slideIndex < 0 || slideIndex >= len(presentation.Slides) {
+		return "", fmt.Errorf("slide index out of range")
+	}
+
+	slideID := presentation.Slides[slideIndex].ObjectId
+	objectID := generateObjectID("chart")
+
+	linkingMode := "NOT_LINKED_IMAGE"
+	if linked {
+		linkingMode = "LINKED"
+	}
+
+	requests := []*slides.Request{
+		{
+			CreateSheetsChart: &slides.CreateSheetsChartRequest{
+				ObjectId:          objectID,
+				SpreadsheetId:     spreadsheetID,
+				ChartId:           chartID,
+				LinkingMode:       linkingMode,
+				ElementProperties: geometry.ElementProperties(slideID, box),
+				ForceSendFields:   []string{"ChartId"},
+			},
+		},
+	}
+
+	_, err = s.slidesService.Presentations.BatchUpdate(presentationID, &slides.BatchUpdatePresentationRequest{
+		Requests: requests,
+	}).Do()
+
+	if err != nil {
+		return "", fmt.Errorf("error adding sheets chart: %w", err)
+	}
+
+	return objectID, nil
+}
+
+// RefreshAll refreshes every linked Sheets chart of a presentation, including
+// charts inside groups, in a single batch and returns their object IDs.
+// Charts inserted as images are not Sheets charts and are left untouched.
+func (s *Service) RefreshAll(ctx context.Context, presentationID string) ([]string, error) {
+	presentation, err := s.slidesService.Presentations.Get(presentationID).Do()
+	if err != nil {
+		return nil, fmt.Errorf("error getting presentation: %w", err)
+	}
+
+	var chartIDs []string
+	var requests []*slides.Request
+	for _, placement := range geometry.Walk(presentation) {
+		if placement.Element.SheetsChart == nil {
+			continue
+		}
+
+		chartIDs = append(chartIDs, placement.Element.ObjectId)
+		requests = append(requests, &slides.Request{
+			RefreshSheetsChart: &slides.RefreshSheetsChartRequest{
+				ObjectId: placement.Element.ObjectId,
+			},
+		})
+	}
+
+	if len(requests) == 0 {
+		return nil, nil
+	}
+
+	_, err = s.slidesService.Presentations.BatchUpdate(presentationID, &slides.BatchUpdatePresentationRequest{
+		Requests: requests,
+	}).Do()
+
+	if err != nil {
+		return nil, fmt.Errorf("error refreshing charts: %w", err)
+	}
+
+	return chartIDs, nil
+}
@@ -4,13 +4,24 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"io"
 	"os"
+	"os/signal"
 	"strconv"
+	"strings"
+	"syscall"
 
 	"github.com/spf13/cobra"
+	"google.golang.org/api/slides/v1"
 
 	"google-slide-manager/internal/auth"
+	"google-slide-manager/internal/chart"
+	"google-slide-manager/internal/element"
 	"google-slide-manager/internal/export"
+	"google-slide-manager/internal/geometry"
+	"google-slide-manager/internal/image"
+	"google-slide-manager/internal/inspect"
+	"google-slide-manager/internal/line"
 	"google-slide-manager/internal/notes"
 	"google-slide-manager/internal/presentation"
 	"google-slide-manager/internal/shape"
@@ -29,7 +40,203 @@ var (
 	addSlidePosition int
 
 	// Table flags
-	styleCellBgColor string
+	createTableX            float64
+	createTableY            float64
+	createTableWidth        float64
+	createTableHeight       float64
+	createTableUnit         string
+	createTableFrom         string
+	createTableHeader       bool
+	createTableFit          bool
+	updateCellAppend        bool
+	updateCellPrepend       bool
+	updateCellCells         string
+	styleCellBgColor        string
+	styleCellAlign          string
+	styleCellBorderColor    string
+	styleCellBorderWeight   float64
+	styleCellBorderDash     string
+	styleCellBorderPosition string
+	styleCellBold           bool
+	styleCellItalic         bool
+	styleCellUnderline      bool
+	styleCellFont           string
+	styleCellSize           float64
+	styleCellColor          string
+	readTableFormat         string
+	readTableMerged         string
+	listTablesFormat        string
+	syncTableHighlight      string
+	syncTableDryRun         bool
+	syncTableFormat         string
+
+	// Table structure flags
+	tableInsertCount int64
+	tableInsertAfter bool
+	tableDeleteCount int64
+	tableSizeUnit    string
+	tableStylePreset string
+	tableStyleFrom   string
+
+	// Text flags
+	searchTextRegex        bool
+	searchTextIncludeNotes bool
+	searchTextFolderID     string
+	searchTextRecursive    bool
+	searchTextConcurrency  int
+
+	// Text formatting flags
+	formatTextRange         string
+	formatTextMatch         string
+	formatTextCell          string
+	formatTextBold          bool
+	formatTextItalic        bool
+	formatTextUnderline     bool
+	formatTextStrikethrough bool
+	formatTextSmallCaps     bool
+	formatTextFont          string
+	formatTextSize          float64
+	formatTextColor         string
+	formatTextBgColor       string
+	formatTextLink          string
+	formatTextBaseline      string
+
+	// Paragraph formatting flags
+	formatParagraphRange           string
+	formatParagraphMatch           string
+	formatParagraphCell            string
+	formatParagraphAlign           string
+	formatParagraphDirection       string
+	formatParagraphLineSpacing     float64
+	formatParagraphSpaceAbove      float64
+	formatParagraphSpaceBelow      float64
+	formatParagraphIndentStart     float64
+	formatParagraphIndentEnd       float64
+	formatParagraphIndentFirstLine float64
+
+	// Bullets flags
+	bulletsRange  string
+	bulletsMatch  string
+	bulletsCell   string
+	bulletsPreset string
+
+	// Set text flags
+	setTextCell string
+
+	// Shape flags
+	addShapeX             float64
+	addShapeY             float64
+	addShapeWidth         float64
+	addShapeHeight        float64
+	addShapeUnit          string
+	addShapeRotation      float64
+	addShapeFill          string
+	addShapeFillAlpha     float64
+	addShapeOutlineColor  string
+	addShapeOutlineWeight float64
+	addShapeDashStyle     string
+	addShapeText          string
+	addShapeVerticalAlign string
+
+	// Text box flags
+	addTextBoxText      string
+	addTextBoxX         float64
+	addTextBoxY         float64
+	addTextBoxWidth     float64
+	addTextBoxHeight    float64
+	addTextBoxUnit      string
+	addTextBoxNoAutofit bool
+
+	// Line flags
+	addLineX1         float64
+	addLineY1         float64
+	addLineX2         float64
+	addLineY2         float64
+	addLineUnit       string
+	addLineFrom       string
+	addLineTo         string
+	addLineFromSite   int64
+	addLineToSite     int64
+	addLineCategory   string
+	addLineStartArrow string
+	addLineEndArrow   string
+	addLineDashStyle  string
+	addLineWeight     float64
+	addLineColor      string
+
+	// Notes flags
+	extractAllNotesFormat string
+
+	// Export flags
+	exportFormat string
+	exportSlides string
+
+	exportScriptFormat      string
+	exportScriptWPM         int
+	exportScriptThumbnails  string
+	exportScriptOutput      string
+	exportImagesSlides      string
+	exportImagesSize        string
+	exportImagesFormat      string
+	exportImagesTemplate    string
+	exportImagesConcurrency int
+	exportImagesRate        float64
+
+	// Image flags
+	addImageX              float64
+	addImageY              float64
+	addImageWidth          float64
+	addImageHeight         float64
+	addImageUnit           string
+	addImageFit            string
+	addImageKeepUpload     bool
+	replaceImageFit        string
+	replaceImageKeepUpload bool
+
+	// Chart flags
+	addChartX      float64
+	addChartY      float64
+	addChartWidth  float64
+	addChartHeight float64
+	addChartUnit   string
+	addChartLinked bool
+
+	// Element flags
+	elementMoveX            float64
+	elementMoveY            float64
+	elementMoveDX           float64
+	elementMoveDY           float64
+	elementMoveUnit         string
+	elementMoveToSlide      int
+	elementResizeWidth      float64
+	elementResizeHeight     float64
+	elementResizeScale      float64
+	elementResizeUnit       string
+	elementRotateDegrees    float64
+	elementRotateBy         float64
+	elementZOrderFront      bool
+	elementZOrderForward    bool
+	elementZOrderBackward   bool
+	elementZOrderBack       bool
+	elementDuplicateDX      float64
+	elementDuplicateDY      float64
+	elementDuplicateUnit    string
+	elementDuplicateToSlide int
+
+	// Layout flags
+	alignLeft            bool
+	alignCenter          bool
+	alignRight           bool
+	alignTop             bool
+	alignMiddle          bool
+	alignBottom          bool
+	alignRelativeTo      string
+	distributeHorizontal bool
+	distributeVertical   bool
+
+	// Inspect flags
+	inspectSlide  int
+	inspectFormat string
 )
 
 var rootCmd = &cobra.Command{
@@ -50,6 +257,10 @@ func init() {
 	initTextCommands()
 	initNotesCommands()
 	initShapeCommands()
+	initImageCommands()
+	initChartCommands()
+	initElementCommands()
+	initInspectCommands()
 	initStyleCommands()
 	initExportCommands()
 }
@@ -259,16 +470,74 @@ func runReorderSlides(cmd *cobra.Command, args []string) error {
 // ==================== Table Commands ====================
 
 func initTableCommands() {
-	styleCellCmd.Flags().StringVar(&styleCellBgColor, "bg-color", "", "Background color (hex, e.g., #FF0000)")
+	createTableCmd.Flags().Float64Var(&createTableX, "x", table.DefaultBox.X, "Left position")
+	createTableCmd.Flags().Float64Var(&createTableY, "y", table.DefaultBox.Y, "Top position")
+	createTableCmd.Flags().Float64Var(&createTableWidth, "width", table.DefaultBox.Width, "Width")
+	createTableCmd.Flags().Float64Var(&createTableHeight, "height", table.DefaultBox.Height, "Height")
+	createTableCmd.Flags().StringVar(&createTableUnit, "unit", "PT", "Unit for position and size (PT, EMU, IN, CM)")
+	createTableCmd.Flags().StringVar(&createTableFrom, "from", "", "Fill the table from a CSV file (- for stdin); rows and cols are taken from the data")
+	createTableCmd.Flags().BoolVar(&createTableHeader, "header", false, "Bold the first row")
+	createTableCmd.Flags().BoolVar(&createTableFit, "fit", false, "Size columns and rows to their content instead of --width/--height")
+	updateCellCmd.Flags().BoolVar(&updateCellAppend, "append", false, "Append to the current cell text instead of replacing it")
+	updateCellCmd.Flags().BoolVar(&updateCellPrepend, "prepend", false, "Prepend to the current cell text instead of replacing it")
+	updateCellCmd.Flags().StringVar(&updateCellCells, "cells", "", "Block of values starting at <row> <col>, as a JSON array of arrays or CSV")
+	updateCellCmd.MarkFlagsMutuallyExclusive("append", "prepend")
+	readTableCmd.Flags().StringVar(&readTableFormat, "format", "csv", "Output format: csv, json or markdown")
+	readTableCmd.Flags().StringVar(&readTableMerged, "merged", table.MergedBlank, "Merged cells: blank leaves covered cells empty, fill repeats the merged text")
+	listTablesCmd.Flags().StringVar(&listTablesFormat, "format", "table", "Output format: table or json")
+	syncTableCmd.Flags().StringVar(&syncTableHighlight, "highlight", "", "Background color for changed cells (e.g., #FFF59D)")
+	syncTableCmd.Flags().BoolVar(&syncTableDryRun, "dry-run", false, "Only report the changes")
+	syncTableCmd.Flags().StringVar(&syncTableFormat, "format", "text", "Report format: text or json")
+	styleCellCmd.Flags().StringVar(&styleCellBgColor, "bg-color", "", "Background color (#RGB, #RRGGBB, #RRGGBBAA, name or theme color)")
+	styleCellCmd.Flags().StringVar(&styleCellAlign, "align", "", "Vertical content alignment: TOP, MIDDLE or BOTTOM")
+	styleCellCmd.Flags().StringVar(&styleCellBorderColor, "border-color", "", "Border color")
+	styleCellCmd.Flags().Float64Var(&styleCellBorderWeight, "border-weight", 0, "Border weight in PT")
+	styleCellCmd.Flags().StringVar(&styleCellBorderDash, "border-dash", "", "Border dash style (SOLID, DOT, DASH, DASH_DOT, LONG_DASH, LONG_DASH_DOT)")
+	styleCellCmd.Flags().StringVar(&styleCellBorderPosition, "border-position", "ALL", "Borders to update (ALL, OUTER, INNER, INNER_HORIZONTAL, INNER_VERTICAL, TOP, BOTTOM, LEFT, RIGHT)")
+	styleCellCmd.Flags().BoolVar(&styleCellBold, "bold", false, "Bold text (use --bold=false to remove)")
+	styleCellCmd.Flags().BoolVar(&styleCellItalic, "italic", false, "Italic text (use --italic=false to remove)")
+	styleCellCmd.Flags().BoolVar(&styleCellUnderline, "underline", false, "Underlined text (use --underline=false to remove)")
+	styleCellCmd.Flags().StringVar(&styleCellFont, "font", "", "Font family")
+	styleCellCmd.Flags().Float64Var(&styleCellSize, "size", 0, "Font size in PT")
+	styleCellCmd.Flags().StringVar(&styleCellColor, "color", "", "Text color")
 	rootCmd.AddCommand(createTableCmd)
 	rootCmd.AddCommand(updateCellCmd)
 	rootCmd.AddCommand(styleCellCmd)
+	rootCmd.AddCommand(readTableCmd)
+	rootCmd.AddCommand(listTablesCmd)
+	rootCmd.AddCommand(syncTableCmd)
+
+	for _, c := range []*cobra.Command{tableInsertRowsCmd, tableInsertColsCmd} {
+		c.Flags().Int64Var(&tableInsertCount, "count", 1, "Number of rows or columns to insert")
+		c.Flags().BoolVar(&tableInsertAfter, "after", false, "Insert below the row or right of the column instead of before it")
+	}
+	for _, c := range []*cobra.Command{tableDeleteRowsCmd, tableDeleteColsCmd} {
+		c.Flags().Int64Var(&tableDeleteCount, "count", 1, "Number of rows or columns to delete")
+	}
+	for _, c := range []*cobra.Command{tableSetColWidthCmd, tableSetRowHeightCmd} {
+		c.Flags().StringVar(&tableSizeUnit, "unit", "PT", "Unit for the size (PT, EMU, IN, CM)")
+	}
+
+	tableApplyStyleCmd.Flags().StringVar(&tableStylePreset, "preset", "", "Preset to apply: one defined in --from, else a built-in ("+strings.Join(table.PresetNames(table.Presets), ", ")+")")
+	tableApplyStyleCmd.Flags().StringVar(&tableStyleFrom, "from", "", "YAML style file defining presets (replaces the built-ins)")
+	tableApplyStyleCmd.MarkFlagsOneRequired("preset", "from")
+
+	tableCmd.AddCommand(tableInsertRowsCmd)
+	tableCmd.AddCommand(tableInsertColsCmd)
+	tableCmd.AddCommand(tableDeleteRowsCmd)
+	tableCmd.AddCommand(tableDeleteColsCmd)
+	tableCmd.AddCommand(tableMergeCmd)
+	tableCmd.AddCommand(tableUnmergeCmd)
+	tableCmd.AddCommand(tableSetColWidthCmd)
+	tableCmd.AddCommand(tableSetRowHeightCmd)
+	tableCmd.AddCommand(tableApplyStyleCmd)
+	rootCmd.AddCommand(tableCmd)
 }
 
 var createTableCmd = &cobra.Command{
-	Use:   "create-table <presentation-id> <slide-index> <rows> <cols>",
-	Short: "Create a table on a slide",
-	Args:  cobra.ExactArgs(4),
+	Use:   "create-table <presentation-id> <slide-index> [<rows> <cols>]",
+	Short: "Create a table on a slide, optionally filled from a CSV file",
+	Args:  cobra.RangeArgs(2, 4),
 	RunE:  runCreateTable,
 }
 
@@ -281,14 +550,1776 @@ func runCreateTable(cmd *cobra.Command, args []string) error {
 		return fmt.Errorf("invalid slide index: %w", err)
 	}
 
-	rows, err := strconv.ParseInt(args[2], 10, 64)
-	if err != nil {
-		return fmt.Errorf("invalid rows: %w", err)
+	var data [][]string
+	var rows, cols int64
+	switch {
+	case createTableFrom != "" && len(args) == 2:
+		data, err = table.ReadCSV(createTableFrom)
+		if err != nil {
+			return err
+		}
+		rows, cols = table.Dimensions(data)
+	case createTableFrom == "" && len(args) == 4:
+		rows, err = strconv.ParseInt(args[2], 10, 64)
+		if err != nil {
+			return fmt.Errorf("invalid rows: %w", err)
+		}
+
+		cols, err = strconv.ParseInt(args[3], 10, 64)
+		if err != nil {
+			return fmt.Errorf("invalid cols: %w", err)
+		}
+	default:
+		return fmt.Errorf("either <rows> <cols> or --from is required, but not both")
+	}
+
+	box, err := geometry.BoxFromUnit(createTableX, createTableY, createTableWidth, createTableHeight, createTableUnit)
+	if err != nil {
+		return err
+	}
+
+	slidesService, err := auth.GetSlidesService(ctx)
+	if err != nil {
+		return err
+	}
+
+	svc := table.NewService(ctx, slidesService)
+	tableID, err := svc.Create(ctx, presentationID, slideIndex, rows, cols, table.CreateOptions{
+		Box:    box,
+		Data:   data,
+		Header: createTableHeader,
+		Fit:    createTableFit,
+	})
+	if err != nil {
+		return err
+	}
+
+	fmt.Fprintf(os.Stderr, "✅ Table created (%dx%d)\n", rows, cols)
+	fmt.Println(tableID)
+
+	return nil
+}
+
+var updateCellCmd = &cobra.Command{
+	Use:   "update-cell <presentation-id> <table-id> <row> <col> [text]",
+	Short: "Replace, append to or prepend to table cell content",
+	Args:  cobra.RangeArgs(4, 5),
+	RunE:  runUpdateCell,
+}
+
+func runUpdateCell(cmd *cobra.Command, args []string) error {
+	ctx := context.Background()
+	presentationID := args[0]
+	tableID := args[1]
+
+	row, err := strconv.ParseInt(args[2], 10, 64)
+	if err != nil {
+		return fmt.Errorf("invalid row: %w", err)
+	}
+
+	col, err := strconv.ParseInt(args[3], 10, 64)
+	if err != nil {
+		return fmt.Errorf("invalid col: %w", err)
+	}
+
+	var data [][]string
+	switch {
+	case len(args) == 5 && !cmd.Flags().Changed("cells"):
+		data = [][]string{{args[4]}}
+	case len(args) == 4 && cmd.Flags().Changed("cells"):
+		data, err = table.ParseCells(updateCellCells)
+		if err != nil {
+			return err
+		}
+	default:
+		return fmt.Errorf("either <text> or --cells is required, but not both")
+	}
+
+	mode := table.ModeReplace
+	switch {
+	case updateCellAppend:
+		mode = table.ModeAppend
+	case updateCellPrepend:
+		mode = table.ModePrepend
+	}
+
+	slidesService, err := auth.GetSlidesService(ctx)
+	if err != nil {
+		return err
+	}
+
+	svc := table.NewService(ctx, slidesService)
+	if err := svc.UpdateCells(ctx, presentationID, tableID, row, col, data, mode); err != nil {
+		return err
+	}
+
+	if len(args) == 5 {
+		fmt.Fprintf(os.Stderr, "✅ Cell updated (row %d, col %d)\n", row, col)
+	} else {
+		rows, cols := table.Dimensions(data)
+		fmt.Fprintf(os.Stderr, "✅ Cells updated (%dx%d block from row %d, col %d)\n", rows, cols, row, col)
+	}
+	return nil
+}
+
+var styleCellCmd = &cobra.Command{
+	Use:   "style-cell <presentation-id> <table-id> (<range> | <row> <col>)",
+	Short: "Style a range of table cells: background, alignment, borders and text",
+	Long: `Style a range of table cells. Ranges use spreadsheet notation (A1, A1:C3),
+row/column indices (r0-2,c1) or 0-based corners (0,0:2,3).`,
+	Args: cobra.RangeArgs(3, 4),
+	RunE: runStyleCell,
+}
+
+func runStyleCell(cmd *cobra.Command, args []string) error {
+	ctx := context.Background()
+	presentationID := args[0]
+	tableID := args[1]
+
+	rangeValue := args[2]
+	if len(args) == 4 {
+		rangeValue = args[2] + "," + args[3]
+	}
+
+	tableRange, err := table.ParseRange(rangeValue)
+	if err != nil {
+		return err
+	}
+
+	style := table.CellStyle{
+		BackgroundColor:  styleCellBgColor,
+		ContentAlignment: styleCellAlign,
+		Border: table.Border{
+			Position:  styleCellBorderPosition,
+			Color:     styleCellBorderColor,
+			Weight:    styleCellBorderWeight,
+			DashStyle: styleCellBorderDash,
+		},
+		Text: text.TextStyle{
+			FontFamily:      styleCellFont,
+			FontSize:        styleCellSize,
+			ForegroundColor: styleCellColor,
+		},
+	}
+	if cmd.Flags().Changed("bold") {
+		style.Text.Bold = &styleCellBold
+	}
+	if cmd.Flags().Changed("italic") {
+		style.Text.Italic = &styleCellItalic
+	}
+	if cmd.Flags().Changed("underline") {
+		style.Text.Underline = &styleCellUnderline
+	}
+
+	slidesService, err := auth.GetSlidesService(ctx)
+	if err != nil {
+		return err
+	}
+
+	svc := table.NewService(ctx, slidesService)
+	if err := svc.StyleCells(ctx, presentationID, tableID, tableRange, style); err != nil {
+		return err
+	}
+
+	fmt.Fprintf(os.Stderr, "✅ Cells styled: %s\n", rangeValue)
+	return nil
+}
+
+var readTableCmd = &cobra.Command{
+	Use:   "read-table <presentation-id> <table-id>",
+	Short: "Print the content of a table as CSV, JSON or Markdown",
+	Args:  cobra.ExactArgs(2),
+	RunE:  runReadTable,
+}
+
+func runReadTable(cmd *cobra.Command, args []string) error {
+	ctx := context.Background()
+	presentationID := args[0]
+	tableID := args[1]
+
+	format := strings.ToLower(readTableFormat)
+	if format != "csv" && format != "json" && format != "markdown" {
+		return fmt.Errorf("invalid format %q (expected csv, json or markdown)", readTableFormat)
+	}
+
+	slidesService, err := auth.GetSlidesService(ctx)
+	if err != nil {
+		return err
+	}
+
+	svc := table.NewService(ctx, slidesService)
+	data, err := svc.Read(ctx, presentationID, tableID, strings.ToLower(readTableMerged))
+	if err != nil {
+		return err
+	}
+
+	switch format {
+	case "json":
+		return printJSON(data)
+	case "markdown":
+		return table.WriteMarkdown(os.Stdout, data)
+	default:
+		return table.WriteCSV(os.Stdout, data)
+	}
+}
+
+var listTablesCmd = &cobra.Command{
+	Use:   "list-tables <presentation-id>",
+	Short: "List every table with its slide, dimensions and header row",
+	Args:  cobra.ExactArgs(1),
+	RunE:  runListTables,
+}
+
+func runListTables(cmd *cobra.Command, args []string) error {
+	ctx := context.Background()
+	presentationID := args[0]
+
+	format := strings.ToLower(listTablesFormat)
+	if format != "table" && format != "json" {
+		return fmt.Errorf("invalid format %q (expected table or json)", listTablesFormat)
+	}
+
+	slidesService, err := auth.GetSlidesService(ctx)
+	if err != nil {
+		return err
+	}
+
+	svc := table.NewService(ctx, slidesService)
+	tables, err := svc.List(ctx, presentationID)
+	if err != nil {
+		return err
+	}
+
+	if format == "json" {
+		return printJSON(tables)
+	}
+	return table.WriteList(os.Stdout, tables)
+}
+
+var syncTableCmd = &cobra.Command{
+	Use:   "sync-table <presentation-id> <table-id> <data.csv>",
+	Short: "Update a table from a CSV file, rewriting only changed cells",
+	Args:  cobra.ExactArgs(3),
+	RunE:  runSyncTable,
+}
+
+func runSyncTable(cmd *cobra.Command, args []string) error {
+	ctx := context.Background()
+	presentationID := args[0]
+	tableID := args[1]
+
+	format := strings.ToLower(syncTableFormat)
+	if format != "text" && format != "json" {
+		return fmt.Errorf("invalid format %q (expected text or json)", syncTableFormat)
+	}
+
+	data, err := table.ReadCSV(args[2])
+	if err != nil {
+		return err
+	}
+
+	slidesService, err := auth.GetSlidesService(ctx)
+	if err != nil {
+		return err
+	}
+
+	svc := table.NewService(ctx, slidesService)
+	report, err := svc.Sync(ctx, presentationID, tableID, data, table.SyncOptions{
+		Highlight: syncTableHighlight,
+		DryRun:    syncTableDryRun,
+	})
+	if err != nil {
+		return err
+	}
+
+	if syncTableDryRun {
+		fmt.Fprintf(os.Stderr, "Dry run: %d cell(s) would change\n", len(report.Changes))
+	} else {
+		fmt.Fprintf(os.Stderr, "✅ Table synced: %d cell(s) changed\n", len(report.Changes))
+	}
+	if len(report.Conflicts) > 0 {
+		fmt.Fprintf(os.Stderr, "⚠️  %d cell(s) covered by merged cells were not written\n", len(report.Conflicts))
+	}
+
+	if format == "json" {
+		return printJSON(report)
+	}
+	return table.WriteReport(os.Stdout, report)
+}
+
+var tableCmd = &cobra.Command{
+	Use:   "table",
+	Short: "Edit table structure: rows, columns, merges and sizes",
+}
+
+var tableInsertRowsCmd = &cobra.Command{
+	Use:   "insert-rows <presentation-id> <table-id> <row>",
+	Short: "Insert rows above (or with --after, below) a row",
+	Args:  cobra.ExactArgs(3),
+	RunE:  runTableInsertRows,
+}
+
+func runTableInsertRows(cmd *cobra.Command, args []string) error {
+	ctx := context.Background()
+	presentationID := args[0]
+	tableID := args[1]
+
+	row, err := strconv.ParseInt(args[2], 10, 64)
+	if err != nil {
+		return fmt.Errorf("invalid row: %w", err)
+	}
+
+	slidesService, err := auth.GetSlidesService(ctx)
+	if err != nil {
+		return err
+	}
+
+	svc := table.NewService(ctx, slidesService)
+	if err := svc.InsertRows(ctx, presentationID, tableID, row, tableInsertCount, tableInsertAfter); err != nil {
+		return err
+	}
+
+	fmt.Fprintf(os.Stderr, "✅ Inserted %d row(s)\n", tableInsertCount)
+	return nil
+}
+
+var tableInsertColsCmd = &cobra.Command{
+	Use:   "insert-cols <presentation-id> <table-id> <col>",
+	Short: "Insert columns left of (or with --after, right of) a column",
+	Args:  cobra.ExactArgs(3),
+	RunE:  runTableInsertCols,
+}
+
+func runTableInsertCols(cmd *cobra.Command, args []string) error {
+	ctx := context.Background()
+	presentationID := args[0]
+	tableID := args[1]
+
+	col, err := strconv.ParseInt(args[2], 10, 64)
+	if err != nil {
+		return fmt.Errorf("invalid col: %w", err)
+	}
+
+	slidesService, err := auth.GetSlidesService(ctx)
+	if err != nil {
+		return err
+	}
+
+	svc := table.NewService(ctx, slidesService)
+	if err := svc.InsertColumns(ctx, presentationID, tableID, col, tableInsertCount, tableInsertAfter); err != nil {
+		return err
+	}
+
+	fmt.Fprintf(os.Stderr, "✅ Inserted %d column(s)\n", tableInsertCount)
+	return nil
+}
+
+var tableDeleteRowsCmd = &cobra.Command{
+	Use:   "delete-rows <presentation-id> <table-id> <row>",
+	Short: "Delete rows starting at a row",
+	Args:  cobra.ExactArgs(3),
+	RunE:  runTableDeleteRows,
+}
+
+func runTableDeleteRows(cmd *cobra.Command, args []string) error {
+	ctx := context.Background()
+	presentationID := args[0]
+	tableID := args[1]
+
+	row, err := strconv.ParseInt(args[2], 10, 64)
+	if err != nil {
+		return fmt.Errorf("invalid row: %w", err)
+	}
+
+	slidesService, err := auth.GetSlidesService(ctx)
+	if err != nil {
+		return err
+	}
+
+	svc := table.NewService(ctx, slidesService)
+	if err := svc.DeleteRows(ctx, presentationID, tableID, row, tableDeleteCount); err != nil {
+		return err
+	}
+
+	fmt.Fprintf(os.Stderr, "✅ Deleted %d row(s)\n", tableDeleteCount)
+	return nil
+}
+
+var tableDeleteColsCmd = &cobra.Command{
+	Use:   "delete-cols <presentation-id> <table-id> <col>",
+	Short: "Delete columns starting at a column",
+	Args:  cobra.ExactArgs(3),
+	RunE:  runTableDeleteCols,
+}
+
+func runTableDeleteCols(cmd *cobra.Command, args []string) error {
+	ctx := context.Background()
+	presentationID := args[0]
+	tableID := args[1]
+
+	col, err := strconv.ParseInt(args[2], 10, 64)
+	if err != nil {
+		return fmt.Errorf("invalid col: %w", err)
+	}
+
+	slidesService, err := auth.GetSlidesService(ctx)
+	if err != nil {
+		return err
+	}
+
+	svc := table.NewService(ctx, slidesService)
+	if err := svc.DeleteColumns(ctx, presentationID, tableID, col, tableDeleteCount); err != nil {
+		return err
+	}
+
+	fmt.Fprintf(os.Stderr, "✅ Deleted %d column(s)\n", tableDeleteCount)
+	return nil
+}
+
+var tableMergeCmd = &cobra.Command{
+	Use:   "merge <presentation-id> <table-id> <range>",
+	Short: "Merge a range of cells",
+	Args:  cobra.ExactArgs(3),
+	RunE:  runTableMerge,
+}
+
+func runTableMerge(cmd *cobra.Command, args []string) error {
+	ctx := context.Background()
+	presentationID := args[0]
+	tableID := args[1]
+
+	tableRange, err := table.ParseRange(args[2])
+	if err != nil {
+		return err
+	}
+
+	slidesService, err := auth.GetSlidesService(ctx)
+	if err != nil {
+		return err
+	}
+
+	svc := table.NewService(ctx, slidesService)
+	if err := svc.Merge(ctx, presentationID, tableID, tableRange); err != nil {
+		return err
+	}
+
+	fmt.Fprintf(os.Stderr, "✅ Cells merged: %s\n", args[2])
+	return nil
+}
+
+var tableUnmergeCmd = &cobra.Command{
+	Use:   "unmerge <presentation-id> <table-id> <range>",
+	Short: "Unmerge the merged cells within a range",
+	Args:  cobra.ExactArgs(3),
+	RunE:  runTableUnmerge,
+}
+
+func runTableUnmerge(cmd *cobra.Command, args []string) error {
+	ctx := context.Background()
+	presentationID := args[0]
+	tableID := args[1]
+
+	tableRange, err := table.ParseRange(args[2])
+	if err != nil {
+		return err
+	}
+
+	slidesService, err := auth.GetSlidesService(ctx)
+	if err != nil {
+		return err
+	}
+
+	svc := table.NewService(ctx, slidesService)
+	if err := svc.Unmerge(ctx, presentationID, tableID, tableRange); err != nil {
+		return err
+	}
+
+	fmt.Fprintf(os.Stderr, "✅ Cells unmerged: %s\n", args[2])
+	return nil
+}
+
+var tableSetColWidthCmd = &cobra.Command{
+	Use:   "set-col-width <presentation-id> <table-id> <cols> <width>",
+	Short: "Set the width of columns (e.g., 0 or 1,3 or 2-4)",
+	Args:  cobra.ExactArgs(4),
+	RunE:  runTableSetColWidth,
+}
+
+func runTableSetColWidth(cmd *cobra.Command, args []string) error {
+	ctx := context.Background()
+	presentationID := args[0]
+	tableID := args[1]
+
+	cols, err := parseIndexList(args[2])
+	if err != nil {
+		return err
+	}
+
+	width, err := strconv.ParseFloat(args[3], 64)
+	if err != nil {
+		return fmt.Errorf("invalid width: %w", err)
+	}
+	width, err = geometry.ToPT(width, tableSizeUnit)
+	if err != nil {
+		return err
+	}
+
+	slidesService, err := auth.GetSlidesService(ctx)
+	if err != nil {
+		return err
+	}
+
+	svc := table.NewService(ctx, slidesService)
+	if err := svc.SetColumnWidth(ctx, presentationID, tableID, cols, width); err != nil {
+		return err
+	}
+
+	fmt.Fprintf(os.Stderr, "✅ Column width set to %.1f PT\n", width)
+	return nil
+}
+
+var tableSetRowHeightCmd = &cobra.Command{
+	Use:   "set-row-height <presentation-id> <table-id> <rows> <height>",
+	Short: "Set the minimum height of rows (e.g., 0 or 1,3 or 2-4)",
+	Args:  cobra.ExactArgs(4),
+	RunE:  runTableSetRowHeight,
+}
+
+func runTableSetRowHeight(cmd *cobra.Command, args []string) error {
+	ctx := context.Background()
+	presentationID := args[0]
+	tableID := args[1]
+
+	rows, err := parseIndexList(args[2])
+	if err != nil {
+		return err
+	}
+
+	height, err := strconv.ParseFloat(args[3], 64)
+	if err != nil {
+		return fmt.Errorf("invalid height: %w", err)
+	}
+	height, err = geometry.ToPT(height, tableSizeUnit)
+	if err != nil {
+		return err
+	}
+
+	slidesService, err := auth.GetSlidesService(ctx)
+	if err != nil {
+		return err
+	}
+
+	svc := table.NewService(ctx, slidesService)
+	if err := svc.SetRowHeight(ctx, presentationID, tableID, rows, height); err != nil {
+		return err
+	}
+
+	fmt.Fprintf(os.Stderr, "✅ Row height set to %.1f PT\n", height)
+	return nil
+}
+
+var tableApplyStyleCmd = &cobra.Command{
+	Use:   "apply-style <presentation-id> <table-id>",
+	Short: "Style a whole table from a preset or a YAML style file",
+	Args:  cobra.ExactArgs(2),
+	RunE:  runTableApplyStyle,
+}
+
+func runTableApplyStyle(cmd *cobra.Command, args []string) error {
+	ctx := context.Background()
+	presentationID := args[0]
+	tableID := args[1]
+
+	presets := table.Presets
+	if tableStyleFrom != "" {
+		loaded, err := table.LoadPresets(tableStyleFrom)
+		if err != nil {
+			return err
+		}
+		presets = loaded
+	}
+
+	theme, err := table.Preset(presets, tableStylePreset)
+	if err != nil {
+		return err
+	}
+
+	slidesService, err := auth.GetSlidesService(ctx)
+	if err != nil {
+		return err
+	}
+
+	svc := table.NewService(ctx, slidesService)
+	if err := svc.ApplyTheme(ctx, presentationID, tableID, theme); err != nil {
+		return err
+	}
+
+	fmt.Fprintf(os.Stderr, "✅ Table style applied: %s\n", tableID)
+	return nil
+}
+
+// ==================== Text Commands ====================
+
+func initTextCommands() {
+	searchTextCmd.Flags().BoolVar(&searchTextRegex, "regex", false, "Treat query as a regular expression (case-sensitive unless it starts with (?i))")
+	searchTextCmd.Flags().BoolVar(&searchTextIncludeNotes, "include-notes", false, "Also search speaker notes")
+	searchTextCmd.Flags().StringVar(&searchTextFolderID, "folder", "", "Search every presentation in this Drive folder instead of a single presentation")
+	searchTextCmd.Flags().BoolVar(&searchTextRecursive, "recursive", false, "Include subfolders when searching a folder")
+	searchTextCmd.Flags().IntVar(&searchTextConcurrency, "concurrency", 8, "Maximum number of presentations searched in parallel")
+	formatTextCmd.Flags().StringVar(&formatTextRange, "range", "", "Text range as start:end (UTF-16 indices, either side may be omitted)")
+	formatTextCmd.Flags().StringVar(&formatTextMatch, "match", "", "Format every occurrence of this text (case-insensitive)")
+	formatTextCmd.Flags().StringVar(&formatTextCell, "cell", "", "Table cell as row,col when the object is a table")
+	formatTextCmd.Flags().BoolVar(&formatTextBold, "bold", false, "Bold (use --bold=false to remove)")
+	formatTextCmd.Flags().BoolVar(&formatTextItalic, "italic", false, "Italic (use --italic=false to remove)")
+	formatTextCmd.Flags().BoolVar(&formatTextUnderline, "underline", false, "Underline (use --underline=false to remove)")
+	formatTextCmd.Flags().BoolVar(&formatTextStrikethrough, "strikethrough", false, "Strikethrough (use --strikethrough=false to remove)")
+	formatTextCmd.Flags().BoolVar(&formatTextSmallCaps, "small-caps", false, "Small caps (use --small-caps=false to remove)")
+	formatTextCmd.Flags().StringVar(&formatTextFont, "font", "", "Font family (e.g., Roboto)")
+	formatTextCmd.Flags().Float64Var(&formatTextSize, "size", 0, "Font size in PT")
+	formatTextCmd.Flags().StringVar(&formatTextColor, "color", "", "Text color (hex, e.g., #FF0000)")
+	formatTextCmd.Flags().StringVar(&formatTextBgColor, "bg-color", "", "Text highlight color (hex, e.g., #FFFF00)")
+	formatTextCmd.Flags().StringVar(&formatTextLink, "link", "", "Link URL")
+	formatTextCmd.Flags().StringVar(&formatTextBaseline, "baseline", "", "Baseline offset (NONE, SUPERSCRIPT, SUBSCRIPT)")
+	formatTextCmd.MarkFlagsMutuallyExclusive("range", "match")
+
+	formatParagraphCmd.Flags().StringVar(&formatParagraphRange, "range", "", "Text range as start:end (UTF-16 indices, either side may be omitted)")
+	formatParagraphCmd.Flags().StringVar(&formatParagraphMatch, "match", "", "Format paragraphs containing this text (case-insensitive)")
+	formatParagraphCmd.Flags().StringVar(&formatParagraphCell, "cell", "", "Table cell as row,col when the object is a table")
+	formatParagraphCmd.Flags().StringVar(&formatParagraphAlign, "align", "", "Alignment (START/LEFT, CENTER, END/RIGHT, JUSTIFIED)")
+	formatParagraphCmd.Flags().StringVar(&formatParagraphDirection, "direction", "", "Text direction (LEFT_TO_RIGHT/LTR, RIGHT_TO_LEFT/RTL)")
+	formatParagraphCmd.Flags().Float64Var(&formatParagraphLineSpacing, "line-spacing", 0, "Line spacing as a percentage of normal (100 = single)")
+	formatParagraphCmd.Flags().Float64Var(&formatParagraphSpaceAbove, "space-above", 0, "Space above paragraphs in PT")
+	formatParagraphCmd.Flags().Float64Var(&formatParagraphSpaceBelow, "space-below", 0, "Space below paragraphs in PT")
+	formatParagraphCmd.Flags().Float64Var(&formatParagraphIndentStart, "indent-start", 0, "Start indentation in PT")
+	formatParagraphCmd.Flags().Float64Var(&formatParagraphIndentEnd, "indent-end", 0, "End indentation in PT")
+	formatParagraphCmd.Flags().Float64Var(&formatParagraphIndentFirstLine, "indent-first-line", 0, "First line indentation in PT")
+	formatParagraphCmd.MarkFlagsMutuallyExclusive("range", "match")
+
+	bulletsCmd.PersistentFlags().StringVar(&bulletsRange, "range", "", "Text range as start:end (UTF-16 indices, either side may be omitted)")
+	bulletsCmd.PersistentFlags().StringVar(&bulletsMatch, "match", "", "Target paragraphs containing this text (case-insensitive)")
+	bulletsCmd.PersistentFlags().StringVar(&bulletsCell, "cell", "", "Table cell as row,col when the object is a table")
+	bulletsAddCmd.Flags().StringVar(&bulletsPreset, "preset", "BULLET_DISC_CIRCLE_SQUARE", "Bullet preset (e.g., BULLET_DISC_CIRCLE_SQUARE, BULLET_CHECKBOX, NUMBERED_DIGIT_ALPHA_ROMAN)")
+	bulletsCmd.AddCommand(bulletsAddCmd)
+	bulletsCmd.AddCommand(bulletsRemoveCmd)
+
+	setTextCmd.Flags().StringVar(&setTextCell, "cell", "", "Table cell as row,col when the object is a table")
+
+	rootCmd.AddCommand(replaceTextCmd)
+	rootCmd.AddCommand(extractAllTextCmd)
+	rootCmd.AddCommand(searchTextCmd)
+	rootCmd.AddCommand(formatTextCmd)
+	rootCmd.AddCommand(formatParagraphCmd)
+	rootCmd.AddCommand(bulletsCmd)
+	rootCmd.AddCommand(setTextCmd)
+}
+
+var replaceTextCmd = &cobra.Command{
+	Use:   "replace-text <presentation-id> <find> <replace>",
+	Short: "Find and replace text in presentation",
+	Args:  cobra.ExactArgs(3),
+	RunE:  runReplaceText,
+}
+
+func runReplaceText(cmd *cobra.Command, args []string) error {
+	ctx := context.Background()
+	presentationID := args[0]
+	findText := args[1]
+	replaceText := args[2]
+
+	slidesService, err := auth.GetSlidesService(ctx)
+	if err != nil {
+		return err
+	}
+
+	svc := text.NewService(ctx, slidesService)
+	if err := svc.Replace(ctx, presentationID, findText, replaceText); err != nil {
+		return err
+	}
+
+	fmt.Fprintf(os.Stderr, "✅ Text replaced: '%s' -> '%s'\n", findText, replaceText)
+	return nil
+}
+
+var extractAllTextCmd = &cobra.Command{
+	Use:   "extract-all-text <presentation-id>",
+	Short: "Extract all text from presentation",
+	Args:  cobra.ExactArgs(1),
+	RunE:  runExtractAllText,
+}
+
+func runExtractAllText(cmd *cobra.Command, args []string) error {
+	ctx := context.Background()
+	presentationID := args[0]
+
+	slidesService, err := auth.GetSlidesService(ctx)
+	if err != nil {
+		return err
+	}
+
+	svc := text.NewService(ctx, slidesService)
+	allText, err := svc.ExtractAll(ctx, presentationID)
+	if err != nil {
+		return err
+	}
+
+	fmt.Println(allText)
+	return nil
+}
+
+var searchTextCmd = &cobra.Command{
+	Use:   "search-text <presentation-id> <query> | --folder <folder-id> <query>",
+	Short: "Search for text in presentation (or every presentation in a folder)",
+	Args: func(cmd *cobra.Command, args []string) error {
+		if searchTextFolderID != "" {
+			return cobra.ExactArgs(1)(cmd, args)
+		}
+		return cobra.ExactArgs(2)(cmd, args)
+	},
+	RunE: runSearchText,
+}
+
+func runSearchText(cmd *cobra.Command, args []string) error {
+	ctx := context.Background()
+
+	if searchTextRecursive && searchTextFolderID == "" {
+		return fmt.Errorf("--recursive requires --folder")
+	}
+
+	opts := text.SearchOptions{
+		Regex:        searchTextRegex,
+		IncludeNotes: searchTextIncludeNotes,
+	}
+
+	slidesService, err := auth.GetSlidesService(ctx)
+	if err != nil {
+		return err
+	}
+
+	svc := text.NewService(ctx, slidesService)
+
+	if searchTextFolderID == "" {
+		results, err := svc.Search(ctx, args[0], args[1], opts)
+		if err != nil {
+			return err
+		}
+		return printJSON(results)
+	}
+
+	driveService, err := auth.GetDriveService(ctx)
+	if err != nil {
+		return err
+	}
+
+	presentationSvc := presentation.NewService(ctx, slidesService, driveService)
+	files, err := presentationSvc.ListInFolder(ctx, searchTextFolderID, searchTextRecursive)
+	if err != nil {
+		return err
+	}
+
+	presentationIDs := make([]string, len(files))
+	names := make(map[string]string, len(files))
+	for i, f := range files {
+		presentationIDs[i] = f.ID
+		names[f.ID] = f.Name
+	}
+
+	fmt.Fprintf(os.Stderr, "🔍 Searching %d presentations...\n", len(files))
+	results, err := svc.SearchPresentations(ctx, presentationIDs, names, args[0], opts, searchTextConcurrency)
+	if err != nil {
+		return err
+	}
+
+	fmt.Fprintf(os.Stderr, "✅ Matches found in %d of %d presentations\n", countWithMatches(results), len(files))
+	return printJSON(results)
+}
+
+// countWithMatches counts the presentations that have at least one match.
+func countWithMatches(results []text.PresentationMatches) int {
+	count := 0
+	for _, r := range results {
+		if len(r.Matches) > 0 {
+			count++
+		}
+	}
+	return count
+}
+
+var formatTextCmd = &cobra.Command{
+	Use:   "format-text <presentation-id> <object-id>",
+	Short: "Apply text style (bold, font, color, link, etc.) to a text range",
+	Args:  cobra.ExactArgs(2),
+	RunE:  runFormatText,
+}
+
+func runFormatText(cmd *cobra.Command, args []string) error {
+	ctx := context.Background()
+	presentationID := args[0]
+	objectID := args[1]
+
+	target, err := parseTextTarget(formatTextRange, formatTextMatch, formatTextCell)
+	if err != nil {
+		return err
+	}
+
+	textStyle := text.TextStyle{
+		FontFamily:      formatTextFont,
+		FontSize:        formatTextSize,
+		ForegroundColor: formatTextColor,
+		BackgroundColor: formatTextBgColor,
+		LinkURL:         formatTextLink,
+		BaselineOffset:  formatTextBaseline,
+	}
+
+	flags := cmd.Flags()
+	if flags.Changed("bold") {
+		textStyle.Bold = &formatTextBold
+	}
+	if flags.Changed("italic") {
+		textStyle.Italic = &formatTextItalic
+	}
+	if flags.Changed("underline") {
+		textStyle.Underline = &formatTextUnderline
+	}
+	if flags.Changed("strikethrough") {
+		textStyle.Strikethrough = &formatTextStrikethrough
+	}
+	if flags.Changed("small-caps") {
+		textStyle.SmallCaps = &formatTextSmallCaps
+	}
+
+	slidesService, err := auth.GetSlidesService(ctx)
+	if err != nil {
+		return err
+	}
+
+	svc := text.NewService(ctx, slidesService)
+	count, err := svc.FormatText(ctx, presentationID, objectID, target, textStyle)
+	if err != nil {
+		return err
+	}
+
+	fmt.Fprintf(os.Stderr, "✅ Text formatted (%d range(s))\n", count)
+	return nil
+}
+
+var formatParagraphCmd = &cobra.Command{
+	Use:   "format-paragraph <presentation-id> <object-id>",
+	Short: "Apply paragraph style (alignment, spacing, indentation, direction)",
+	Args:  cobra.ExactArgs(2),
+	RunE:  runFormatParagraph,
+}
+
+func runFormatParagraph(cmd *cobra.Command, args []string) error {
+	ctx := context.Background()
+	presentationID := args[0]
+	objectID := args[1]
+
+	target, err := parseTextTarget(formatParagraphRange, formatParagraphMatch, formatParagraphCell)
+	if err != nil {
+		return err
+	}
+
+	paragraphStyle := text.ParagraphStyle{
+		Alignment: formatParagraphAlign,
+		Direction: formatParagraphDirection,
+	}
+
+	flags := cmd.Flags()
+	if flags.Changed("line-spacing") {
+		paragraphStyle.LineSpacing = &formatParagraphLineSpacing
+	}
+	if flags.Changed("space-above") {
+		paragraphStyle.SpaceAbove = &formatParagraphSpaceAbove
+	}
+	if flags.Changed("space-below") {
+		paragraphStyle.SpaceBelow = &formatParagraphSpaceBelow
+	}
+	if flags.Changed("indent-start") {
+		paragraphStyle.IndentStart = &formatParagraphIndentStart
+	}
+	if flags.Changed("indent-end") {
+		paragraphStyle.IndentEnd = &formatParagraphIndentEnd
+	}
+	if flags.Changed("indent-first-line") {
+		paragraphStyle.IndentFirstLine = &formatParagraphIndentFirstLine
+	}
+
+	slidesService, err := auth.GetSlidesService(ctx)
+	if err != nil {
+		return err
+	}
+
+	svc := text.NewService(ctx, slidesService)
+	count, err := svc.FormatParagraph(ctx, presentationID, objectID, target, paragraphStyle)
+	if err != nil {
+		return err
+	}
+
+	fmt.Fprintf(os.Stderr, "✅ Paragraphs formatted (%d range(s))\n", count)
+	return nil
+}
+
+var setTextCmd = &cobra.Command{
+	Use:   "set-text <presentation-id> <object-id> <text>",
+	Short: "Replace the full text of a shape or table cell",
+	Args:  cobra.ExactArgs(3),
+	RunE:  runSetText,
+}
+
+func runSetText(cmd *cobra.Command, args []string) error {
+	ctx := context.Background()
+	presentationID := args[0]
+	objectID := args[1]
+	textContent := args[2]
+
+	var cell *slides.TableCellLocation
+	if setTextCell != "" {
+		var err error
+		cell, err = parseCellLocation(setTextCell)
+		if err != nil {
+			return err
+		}
+	}
+
+	slidesService, err := auth.GetSlidesService(ctx)
+	if err != nil {
+		return err
+	}
+
+	svc := text.NewService(ctx, slidesService)
+	if err := svc.SetText(ctx, presentationID, objectID, cell, textContent); err != nil {
+		return err
+	}
+
+	fmt.Fprintf(os.Stderr, "✅ Text set on %s\n", objectID)
+	return nil
+}
+
+var bulletsCmd = &cobra.Command{
+	Use:   "bullets",
+	Short: "Add or remove paragraph bullets",
+}
+
+var bulletsAddCmd = &cobra.Command{
+	Use:   "add <presentation-id> <object-id>",
+	Short: "Turn paragraphs into a bulleted or numbered list",
+	Args:  cobra.ExactArgs(2),
+	RunE:  runBulletsAdd,
+}
+
+func runBulletsAdd(cmd *cobra.Command, args []string) error {
+	ctx := context.Background()
+	presentationID := args[0]
+	objectID := args[1]
+
+	target, err := parseTextTarget(bulletsRange, bulletsMatch, bulletsCell)
+	if err != nil {
+		return err
+	}
+
+	slidesService, err := auth.GetSlidesService(ctx)
+	if err != nil {
+		return err
+	}
+
+	svc := text.NewService(ctx, slidesService)
+	if err := svc.AddBullets(ctx, presentationID, objectID, target, bulletsPreset); err != nil {
+		return err
+	}
+
+	fmt.Fprintf(os.Stderr, "✅ Bullets added (%s)\n", strings.ToUpper(bulletsPreset))
+	return nil
+}
+
+var bulletsRemoveCmd = &cobra.Command{
+	Use:   "remove <presentation-id> <object-id>",
+	Short: "Remove bullets from paragraphs",
+	Args:  cobra.ExactArgs(2),
+	RunE:  runBulletsRemove,
+}
+
+func runBulletsRemove(cmd *cobra.Command, args []string) error {
+	ctx := context.Background()
+	presentationID := args[0]
+	objectID := args[1]
+
+	target, err := parseTextTarget(bulletsRange, bulletsMatch, bulletsCell)
+	if err != nil {
+		return err
+	}
+
+	slidesService, err := auth.GetSlidesService(ctx)
+	if err != nil {
+		return err
+	}
+
+	svc := text.NewService(ctx, slidesService)
+	if err := svc.RemoveBullets(ctx, presentationID, objectID, target); err != nil {
+		return err
+	}
+
+	fmt.Fprintf(os.Stderr, "✅ Bullets removed\n")
+	return nil
+}
+
+// ==================== Notes Commands ====================
+
+func initNotesCommands() {
+	extractAllNotesCmd.Flags().StringVar(&extractAllNotesFormat, "format", "json", "Output format: json or markdown (md)")
+	rootCmd.AddCommand(getNotesCmd)
+	rootCmd.AddCommand(setNotesCmd)
+	rootCmd.AddCommand(appendNotesCmd)
+	rootCmd.AddCommand(clearNotesCmd)
+	rootCmd.AddCommand(extractAllNotesCmd)
+	rootCmd.AddCommand(importNotesCmd)
+}
+
+var getNotesCmd = &cobra.Command{
+	Use:   "get-notes <presentation-id> <slide-index>",
+	Short: "Get speaker notes from a slide",
+	Args:  cobra.ExactArgs(2),
+	RunE:  runGetNotes,
+}
+
+func runGetNotes(cmd *cobra.Command, args []string) error {
+	ctx := context.Background()
+	presentationID := args[0]
+
+	slideIndex, err := strconv.Atoi(args[1])
+	if err != nil {
+		return fmt.Errorf("invalid slide index: %w", err)
+	}
+
+	slidesService, err := auth.GetSlidesService(ctx)
+	if err != nil {
+		return err
+	}
+
+	svc := notes.NewService(ctx, slidesService)
+	notesText, err := svc.Get(ctx, presentationID, slideIndex)
+	if err != nil {
+		return err
+	}
+
+	fmt.Println(notesText)
+	return nil
+}
+
+var setNotesCmd = &cobra.Command{
+	Use:   "set-notes <presentation-id> <slide-index> <notes>",
+	Short: "Replace the speaker notes of a slide",
+	Args:  cobra.ExactArgs(3),
+	RunE:  runSetNotes,
+}
+
+func runSetNotes(cmd *cobra.Command, args []string) error {
+	ctx := context.Background()
+	presentationID := args[0]
+
+	slideIndex, err := strconv.Atoi(args[1])
+	if err != nil {
+		return fmt.Errorf("invalid slide index: %w", err)
+	}
+
+	notesContent := args[2]
+
+	slidesService, err := auth.GetSlidesService(ctx)
+	if err != nil {
+		return err
+	}
+
+	svc := notes.NewService(ctx, slidesService)
+	if err := svc.Set(ctx, presentationID, slideIndex, notesContent); err != nil {
+		return err
+	}
+
+	fmt.Fprintf(os.Stderr, "✅ Notes set on slide %d\n", slideIndex)
+	return nil
+}
+
+var appendNotesCmd = &cobra.Command{
+	Use:     "append-notes <presentation-id> <slide-index> <notes>",
+	Aliases: []string{"add-notes"},
+	Short:   "Append to the speaker notes of a slide",
+	Args:    cobra.ExactArgs(3),
+	RunE:    runAppendNotes,
+}
+
+func runAppendNotes(cmd *cobra.Command, args []string) error {
+	ctx := context.Background()
+	presentationID := args[0]
+
+	slideIndex, err := strconv.Atoi(args[1])
+	if err != nil {
+		return fmt.Errorf("invalid slide index: %w", err)
+	}
+
+	notesContent := args[2]
+
+	slidesService, err := auth.GetSlidesService(ctx)
+	if err != nil {
+		return err
+	}
+
+	svc := notes.NewService(ctx, slidesService)
+	if err := svc.Append(ctx, presentationID, slideIndex, notesContent); err != nil {
+		return err
+	}
+
+	fmt.Fprintf(os.Stderr, "✅ Notes appended to slide %d\n", slideIndex)
+	return nil
+}
+
+var clearNotesCmd = &cobra.Command{
+	Use:   "clear-notes <presentation-id> <slide-index>",
+	Short: "Remove the speaker notes of a slide",
+	Args:  cobra.ExactArgs(2),
+	RunE:  runClearNotes,
+}
+
+func runClearNotes(cmd *cobra.Command, args []string) error {
+	ctx := context.Background()
+	presentationID := args[0]
+
+	slideIndex, err := strconv.Atoi(args[1])
+	if err != nil {
+		return fmt.Errorf("invalid slide index: %w", err)
+	}
+
+	slidesService, err := auth.GetSlidesService(ctx)
+	if err != nil {
+		return err
+	}
+
+	svc := notes.NewService(ctx, slidesService)
+	if err := svc.Clear(ctx, presentationID, slideIndex); err != nil {
+		return err
+	}
+
+	fmt.Fprintf(os.Stderr, "✅ Notes cleared on slide %d\n", slideIndex)
+	return nil
+}
+
+var extractAllNotesCmd = &cobra.Command{
+	Use:   "extract-all-notes <presentation-id>",
+	Short: "Extract the speaker notes of every slide, in order",
+	Args:  cobra.ExactArgs(1),
+	RunE:  runExtractAllNotes,
+}
+
+func runExtractAllNotes(cmd *cobra.Command, args []string) error {
+	ctx := context.Background()
+	presentationID := args[0]
+
+	format := strings.ToLower(extractAllNotesFormat)
+	if format == "md" {
+		format = "markdown"
+	}
+	if format != "json" && format != "markdown" {
+		return fmt.Errorf("invalid format %q (expected json, markdown or md)", extractAllNotesFormat)
+	}
+
+	slidesService, err := auth.GetSlidesService(ctx)
+	if err != nil {
+		return err
+	}
+
+	svc := notes.NewService(ctx, slidesService)
+	allNotes, err := svc.ExtractAll(ctx, presentationID)
+	if err != nil {
+		return err
+	}
+
+	if format == "markdown" {
+		return notes.WriteMarkdown(os.Stdout, allNotes)
+	}
+	return printJSON(allNotes)
+}
+
+var importNotesCmd = &cobra.Command{
+	Use:   "import-notes <presentation-id> <notes.json|notes.md>",
+	Short: "Replace the speaker notes of many slides from a JSON or Markdown file",
+	Args:  cobra.ExactArgs(2),
+	RunE:  runImportNotes,
+}
+
+func runImportNotes(cmd *cobra.Command, args []string) error {
+	ctx := context.Background()
+	presentationID := args[0]
+
+	entries, err := notes.ReadFile(args[1])
+	if err != nil {
+		return err
+	}
+
+	slidesService, err := auth.GetSlidesService(ctx)
+	if err != nil {
+		return err
+	}
+
+	svc := notes.NewService(ctx, slidesService)
+	changed, err := svc.Import(ctx, presentationID, entries)
+	if err != nil {
+		return err
+	}
+
+	fmt.Fprintf(os.Stderr, "✅ Notes imported: %d of %d slide(s) changed\n", changed, len(entries))
+	return nil
+}
+
+// ==================== Shape Commands ====================
+
+func initShapeCommands() {
+	addShapeCmd.Flags().Float64Var(&addShapeX, "x", 100, "Left position")
+	addShapeCmd.Flags().Float64Var(&addShapeY, "y", 100, "Top position")
+	addShapeCmd.Flags().Float64Var(&addShapeWidth, "width", 100, "Width")
+	addShapeCmd.Flags().Float64Var(&addShapeHeight, "height", 100, "Height")
+	addShapeCmd.Flags().StringVar(&addShapeUnit, "unit", "PT", "Unit for position, size and outline weight (PT, EMU, IN, CM)")
+	addShapeCmd.Flags().Float64Var(&addShapeRotation, "rotation", 0, "Clockwise rotation in degrees")
+	addShapeCmd.Flags().StringVar(&addShapeFill, "fill", "", "Fill color (hex, e.g., #4285F4)")
+	addShapeCmd.Flags().Float64Var(&addShapeFillAlpha, "fill-alpha", 1, "Fill opacity between 0 and 1")
+	addShapeCmd.Flags().StringVar(&addShapeOutlineColor, "outline-color", "", "Outline color (hex)")
+	addShapeCmd.Flags().Float64Var(&addShapeOutlineWeight, "outline-weight", 0, "Outline weight")
+	addShapeCmd.Flags().StringVar(&addShapeDashStyle, "dash-style", "", "Outline dash style (SOLID, DOT, DASH, DASH_DOT, LONG_DASH, LONG_DASH_DOT)")
+	addShapeCmd.Flags().StringVar(&addShapeText, "text", "", "Initial text of the shape")
+	addShapeCmd.Flags().StringVar(&addShapeVerticalAlign, "vertical-align", "", "Vertical text alignment (TOP, MIDDLE, BOTTOM)")
+	addTextBoxCmd.Flags().StringVar(&addTextBoxText, "text", "", "Text content of the text box")
+	addTextBoxCmd.Flags().Float64Var(&addTextBoxX, "x", 100, "Left position")
+	addTextBoxCmd.Flags().Float64Var(&addTextBoxY, "y", 100, "Top position")
+	addTextBoxCmd.Flags().Float64Var(&addTextBoxWidth, "width", 300, "Width")
+	addTextBoxCmd.Flags().Float64Var(&addTextBoxHeight, "height", 50, "Height")
+	addTextBoxCmd.Flags().StringVar(&addTextBoxUnit, "unit", "PT", "Unit for position and size (PT, EMU, IN, CM)")
+	addTextBoxCmd.Flags().BoolVar(&addTextBoxNoAutofit, "no-autofit", false, "Turn autofit off (the Slides API cannot enable autofit)")
+	addLineCmd.Flags().Float64Var(&addLineX1, "x1", 100, "Start X (ignored with --from)")
+	addLineCmd.Flags().Float64Var(&addLineY1, "y1", 100, "Start Y (ignored with --from)")
+	addLineCmd.Flags().Float64Var(&addLineX2, "x2", 300, "End X (ignored with --to)")
+	addLineCmd.Flags().Float64Var(&addLineY2, "y2", 100, "End Y (ignored with --to)")
+	addLineCmd.Flags().StringVar(&addLineUnit, "unit", "PT", "Unit for coordinates and weight (PT, EMU, IN, CM)")
+	addLineCmd.Flags().StringVar(&addLineFrom, "from", "", "Object ID to connect the start of the line to")
+	addLineCmd.Flags().StringVar(&addLineTo, "to", "", "Object ID to connect the end of the line to")
+	addLineCmd.Flags().Int64Var(&addLineFromSite, "from-site", 0, "Connection site index on the start element (default: closest site)")
+	addLineCmd.Flags().Int64Var(&addLineToSite, "to-site", 0, "Connection site index on the end element (default: closest site)")
+	addLineCmd.Flags().StringVar(&addLineCategory, "category", "STRAIGHT", "Line category (STRAIGHT, BENT, CURVED)")
+	addLineCmd.Flags().StringVar(&addLineStartArrow, "start-arrow", "", "Start arrowhead (NONE, FILL_ARROW, STEALTH_ARROW, OPEN_ARROW, FILL_CIRCLE, ...)")
+	addLineCmd.Flags().StringVar(&addLineEndArrow, "end-arrow", "", "End arrowhead (NONE, FILL_ARROW, STEALTH_ARROW, OPEN_ARROW, FILL_CIRCLE, ...)")
+	addLineCmd.Flags().StringVar(&addLineDashStyle, "dash-style", "", "Dash style (SOLID, DOT, DASH, DASH_DOT, LONG_DASH, LONG_DASH_DOT)")
+	addLineCmd.Flags().Float64Var(&addLineWeight, "weight", 0, "Line weight")
+	addLineCmd.Flags().StringVar(&addLineColor, "color", "", "Line color (hex, e.g., #333333)")
+	rootCmd.AddCommand(addShapeCmd)
+	rootCmd.AddCommand(addTextBoxCmd)
+	rootCmd.AddCommand(addLineCmd)
+}
+
+var addShapeCmd = &cobra.Command{
+	Use:   "add-shape <presentation-id> <slide-index> <shape-type>",
+	Short: "Add a shape to a slide (RECTANGLE, ELLIPSE, etc.)",
+	Args:  cobra.ExactArgs(3),
+	RunE:  runAddShape,
+}
+
+func runAddShape(cmd *cobra.Command, args []string) error {
+	ctx := context.Background()
+	presentationID := args[0]
+
+	slideIndex, err := strconv.Atoi(args[1])
+	if err != nil {
+		return fmt.Errorf("invalid slide index: %w", err)
+	}
+
+	shapeType := args[2]
+
+	box, err := geometry.BoxFromUnit(addShapeX, addShapeY, addShapeWidth, addShapeHeight, addShapeUnit)
+	if err != nil {
+		return err
+	}
+
+	outlineWeight, err := geometry.ToPT(addShapeOutlineWeight, addShapeUnit)
+	if err != nil {
+		return err
+	}
+
+	opts := shape.Options{
+		Box:           box,
+		Rotation:      addShapeRotation,
+		FillColor:     addShapeFill,
+		OutlineColor:  addShapeOutlineColor,
+		OutlineWeight: outlineWeight,
+		DashStyle:     addShapeDashStyle,
+		Text:          addShapeText,
+		VerticalAlign: addShapeVerticalAlign,
+	}
+	if cmd.Flags().Changed("fill-alpha") {
+		opts.FillAlpha = &addShapeFillAlpha
+	}
+
+	slidesService, err := auth.GetSlidesService(ctx)
+	if err != nil {
+		return err
+	}
+
+	svc := shape.NewService(ctx, slidesService)
+	shapeID, err := svc.Add(ctx, presentationID, slideIndex, shapeType, opts)
+	if err != nil {
+		return err
+	}
+
+	fmt.Fprintf(os.Stderr, "✅ Shape added: %s\n", shapeType)
+	fmt.Println(shapeID)
+
+	return nil
+}
+
+var addTextBoxCmd = &cobra.Command{
+	Use:   "add-textbox <presentation-id> <slide-index>",
+	Short: "Add a text box with position, size and content",
+	Args:  cobra.ExactArgs(2),
+	RunE:  runAddTextBox,
+}
+
+func runAddTextBox(cmd *cobra.Command, args []string) error {
+	ctx := context.Background()
+	presentationID := args[0]
+
+	slideIndex, err := strconv.Atoi(args[1])
+	if err != nil {
+		return fmt.Errorf("invalid slide index: %w", err)
+	}
+
+	box, err := geometry.BoxFromUnit(addTextBoxX, addTextBoxY, addTextBoxWidth, addTextBoxHeight, addTextBoxUnit)
+	if err != nil {
+		return err
+	}
+
+	slidesService, err := auth.GetSlidesService(ctx)
+	if err != nil {
+		return err
+	}
+
+	svc := shape.NewService(ctx, slidesService)
+	textBoxID, err := svc.AddTextBox(ctx, presentationID, slideIndex, box, addTextBoxText, addTextBoxNoAutofit)
+	if err != nil {
+		return err
+	}
+
+	fmt.Fprintf(os.Stderr, "✅ Text box added\n")
+	fmt.Println(textBoxID)
+
+	return nil
+}
+
+var addLineCmd = &cobra.Command{
+	Use:   "add-line <presentation-id> <slide-index>",
+	Short: "Add a line or connector between points or elements",
+	Args:  cobra.ExactArgs(2),
+	RunE:  runAddLine,
+}
+
+func runAddLine(cmd *cobra.Command, args []string) error {
+	ctx := context.Background()
+	presentationID := args[0]
+
+	slideIndex, err := strconv.Atoi(args[1])
+	if err != nil {
+		return fmt.Errorf("invalid slide index: %w", err)
+	}
+
+	coords := []float64{addLineX1, addLineY1, addLineX2, addLineY2, addLineWeight}
+	for i, v := range coords {
+		coords[i], err = geometry.ToPT(v, addLineUnit)
+		if err != nil {
+			return err
+		}
+	}
+
+	ends := line.Endpoints{
+		X1:   coords[0],
+		Y1:   coords[1],
+		X2:   coords[2],
+		Y2:   coords[3],
+		From: addLineFrom,
+		To:   addLineTo,
+	}
+
+	opts := line.Options{
+		Category:   addLineCategory,
+		StartArrow: addLineStartArrow,
+		EndArrow:   addLineEndArrow,
+		DashStyle:  addLineDashStyle,
+		Weight:     coords[4],
+		Color:      addLineColor,
+	}
+	if cmd.Flags().Changed("from-site") {
+		opts.FromSite = &addLineFromSite
+	}
+	if cmd.Flags().Changed("to-site") {
+		opts.ToSite = &addLineToSite
+	}
+
+	slidesService, err := auth.GetSlidesService(ctx)
+	if err != nil {
+		return err
+	}
+
+	svc := line.NewService(ctx, slidesService)
+	lineID, err := svc.Add(ctx, presentationID, slideIndex, ends, opts)
+	if err != nil {
+		return err
+	}
+
+	fmt.Fprintf(os.Stderr, "✅ Line added\n")
+	fmt.Println(lineID)
+
+	return nil
+}
+
+// ==================== Image Commands ====================
+
+func initImageCommands() {
+	addImageCmd.Flags().Float64Var(&addImageX, "x", 100, "Left position")
+	addImageCmd.Flags().Float64Var(&addImageY, "y", 100, "Top position")
+	addImageCmd.Flags().Float64Var(&addImageWidth, "width", 300, "Width")
+	addImageCmd.Flags().Float64Var(&addImageHeight, "height", 200, "Height")
+	addImageCmd.Flags().StringVar(&addImageUnit, "unit", "PT", "Unit for position and size (PT, EMU, IN, CM)")
+	addImageCmd.Flags().StringVar(&addImageFit, "fit", "", "Size from the image pixel dimensions: contain or cover")
+	addImageCmd.Flags().BoolVar(&addImageKeepUpload, "keep-upload", false, "Keep the Drive upload of a local file (readable by anyone with the link) instead of deleting it")
+	replaceImageCmd.Flags().StringVar(&replaceImageFit, "fit", "contain", "Replacement method: contain (CENTER_INSIDE) or cover (CENTER_CROP)")
+	replaceImageCmd.Flags().BoolVar(&replaceImageKeepUpload, "keep-upload", false, "Keep the Drive upload of a local file (readable by anyone with the link) instead of deleting it")
+	rootCmd.AddCommand(addImageCmd)
+	rootCmd.AddCommand(replaceImageCmd)
+}
+
+var addImageCmd = &cobra.Command{
+	Use:   "add-image <presentation-id> <slide-index> <url|path>",
+	Short: "Insert an image from a URL or local file",
+	Args:  cobra.ExactArgs(3),
+	RunE:  runAddImage,
+}
+
+func runAddImage(cmd *cobra.Command, args []string) error {
+	ctx := context.Background()
+	presentationID := args[0]
+
+	slideIndex, err := strconv.Atoi(args[1])
+	if err != nil {
+		return fmt.Errorf("invalid slide index: %w", err)
+	}
+
+	source := args[2]
+
+	box, err := geometry.BoxFromUnit(addImageX, addImageY, addImageWidth, addImageHeight, addImageUnit)
+	if err != nil {
+		return err
+	}
+
+	slidesService, err := auth.GetSlidesService(ctx)
+	if err != nil {
+		return err
+	}
+
+	driveService, err := auth.GetDriveService(ctx)
+	if err != nil {
+		return err
+	}
+
+	svc := image.NewService(ctx, slidesService, driveService)
+	imageID, err := svc.Add(ctx, presentationID, slideIndex, source, image.Options{
+		Box:        box,
+		Fit:        strings.ToLower(addImageFit),
+		KeepUpload: addImageKeepUpload,
+	})
+	if err != nil {
+		return err
+	}
+
+	fmt.Fprintf(os.Stderr, "✅ Image added\n")
+	fmt.Println(imageID)
+
+	return nil
+}
+
+var replaceImageCmd = &cobra.Command{
+	Use:   "replace-image <presentation-id> <object-id> <url|path>",
+	Short: "Replace an existing image, keeping its position and size",
+	Args:  cobra.ExactArgs(3),
+	RunE:  runReplaceImage,
+}
+
+func runReplaceImage(cmd *cobra.Command, args []string) error {
+	ctx := context.Background()
+	presentationID := args[0]
+	objectID := args[1]
+	source := args[2]
+
+	slidesService, err := auth.GetSlidesService(ctx)
+	if err != nil {
+		return err
+	}
+
+	driveService, err := auth.GetDriveService(ctx)
+	if err != nil {
+		return err
+	}
+
+	svc := image.NewService(ctx, slidesService, driveService)
+	if err := svc.Replace(ctx, presentationID, objectID, source, strings.ToLower(replaceImageFit), replaceImageKeepUpload); err != nil {
+		return err
+	}
+
+	fmt.Fprintf(os.Stderr, "✅ Image replaced: %s\n", objectID)
+	return nil
+}
+
+// ==================== Chart Commands ====================
+
+func initChartCommands() {
+	addSheetsChartCmd.Flags().Float64Var(&addChartX, "x", 100, "Left position")
+	addSheetsChartCmd.Flags().Float64Var(&addChartY, "y", 100, "Top position")
+	addSheetsChartCmd.Flags().Float64Var(&addChartWidth, "width", 400, "Width")
+	addSheetsChartCmd.Flags().Float64Var(&addChartHeight, "height", 250, "Height")
+	addSheetsChartCmd.Flags().StringVar(&addChartUnit, "unit", "PT", "Unit for position and size (PT, EMU, IN, CM)")
+	addSheetsChartCmd.Flags().BoolVar(&addChartLinked, "linked", false, "Keep the chart linked to the spreadsheet so it can be refreshed (default: static image)")
+	rootCmd.AddCommand(addSheetsChartCmd)
+	rootCmd.AddCommand(refreshChartsCmd)
+}
+
+var addSheetsChartCmd = &cobra.Command{
+	Use:   "add-sheets-chart <presentation-id> <slide-index> <spreadsheet-id> <chart-id>",
+	Short: "Embed a chart from a Google Sheets spreadsheet",
+	Args:  cobra.ExactArgs(4),
+	RunE:  runAddSheetsChart,
+}
+
+func runAddSheetsChart(cmd *cobra.Command, args []string) error {
+	ctx := context.Background()
+	presentationID := args[0]
+
+	slideIndex, err := strconv.Atoi(args[1])
+	if err != nil {
+		return fmt.Errorf("invalid slide index: %w", err)
+	}
+
+	spreadsheetID := args[2]
+
+	chartID, err := strconv.ParseInt(args[3], 10, 64)
+	if err != nil {
+		return fmt.Errorf("invalid chart ID: %w", err)
+	}
+
+	box, err := geometry.BoxFromUnit(addChartX, addChartY, addChartWidth, addChartHeight, addChartUnit)
+	if err != nil {
+		return err
+	}
+
+	slidesService, err := auth.GetSlidesService(ctx)
+	if err != nil {
+		return err
+	}
+
+	svc := chart.NewService(ctx, slidesService)
+	objectID, err := svc.AddSheetsChart(ctx, presentationID, slideIndex, spreadsheetID, chartID, addChartLinked, box)
+	if err != nil {
+		return err
+	}
+
+	if addChartLinked {
+		fmt.Fprintf(os.Stderr, "✅ Linked chart added\n")
+	} else {
+		fmt.Fprintf(os.Stderr, "✅ Chart added as image\n")
+	}
+	fmt.Println(objectID)
+
+	return nil
+}
+
+var refreshChartsCmd = &cobra.Command{
+	Use:   "refresh-charts <presentation-id>",
+	Short: "Refresh every linked Google Sheets chart of a presentation",
+	Args:  cobra.ExactArgs(1),
+	RunE:  runRefreshCharts,
+}
+
+func runRefreshCharts(cmd *cobra.Command, args []string) error {
+	ctx := context.Background()
+	presentationID := args[0]
+
+	slidesService, err := auth.GetSlidesService(ctx)
+	if err != nil {
+		return err
+	}
+
+	svc := chart.NewService(ctx, slidesService)
+	chartIDs, err := svc.RefreshAll(ctx, presentationID)
+	if err != nil {
+		return err
+	}
+
+	if len(chartIDs) == 0 {
+		fmt.Fprintf(os.Stderr, "No linked charts found\n")
+		return nil
+	}
+
+	fmt.Fprintf(os.Stderr, "✅ Refreshed %d chart(s)\n", len(chartIDs))
+	for _, chartID := range chartIDs {
+		fmt.Println(chartID)
+	}
+
+	return nil
+}
+
+// ==================== Element Commands ====================
+
+func initElementCommands() {
+	elementMoveCmd.Flags().Float64Var(&elementMoveX, "x", 0, "Absolute left position of the bounding box")
+	elementMoveCmd.Flags().Float64Var(&elementMoveY, "y", 0, "Absolute top position of the bounding box")
+	elementMoveCmd.Flags().Float64Var(&elementMoveDX, "dx", 0, "Horizontal offset")
+	elementMoveCmd.Flags().Float64Var(&elementMoveDY, "dy", 0, "Vertical offset")
+	elementMoveCmd.Flags().StringVar(&elementMoveUnit, "unit", "PT", "Unit for positions and offsets (PT, EMU, IN, CM)")
+	elementMoveCmd.Flags().IntVar(&elementMoveToSlide, "to-slide", 0, "Move to another slide (recreates the element with new IDs)")
+	elementMoveCmd.MarkFlagsMutuallyExclusive("x", "dx")
+	elementMoveCmd.MarkFlagsMutuallyExclusive("y", "dy")
+
+	elementResizeCmd.Flags().Float64Var(&elementResizeWidth, "width", 0, "New width")
+	elementResizeCmd.Flags().Float64Var(&elementResizeHeight, "height", 0, "New height")
+	elementResizeCmd.Flags().Float64Var(&elementResizeScale, "scale", 0, "Scale factor applied to both dimensions")
+	elementResizeCmd.Flags().StringVar(&elementResizeUnit, "unit", "PT", "Unit for width and height (PT, EMU, IN, CM)")
+	elementResizeCmd.MarkFlagsMutuallyExclusive("width", "scale")
+	elementResizeCmd.MarkFlagsMutuallyExclusive("height", "scale")
+
+	elementRotateCmd.Flags().Float64Var(&elementRotateDegrees, "degrees", 0, "Absolute rotation in degrees (clockwise)")
+	elementRotateCmd.Flags().Float64Var(&elementRotateBy, "by", 0, "Relative rotation in degrees (clockwise)")
+	elementRotateCmd.MarkFlagsMutuallyExclusive("degrees", "by")
+	elementRotateCmd.MarkFlagsOneRequired("degrees", "by")
+
+	elementZOrderCmd.Flags().BoolVar(&elementZOrderFront, "bring-to-front", false, "Bring to the front of the page")
+	elementZOrderCmd.Flags().BoolVar(&elementZOrderForward, "bring-forward", false, "Bring forward by one element")
+	elementZOrderCmd.Flags().BoolVar(&elementZOrderBackward, "send-backward", false, "Send backward by one element")
+	elementZOrderCmd.Flags().BoolVar(&elementZOrderBack, "send-to-back", false, "Send to the back of the page")
+	elementZOrderCmd.MarkFlagsMutuallyExclusive("bring-to-front", "bring-forward", "send-backward", "send-to-back")
+	elementZOrderCmd.MarkFlagsOneRequired("bring-to-front", "bring-forward", "send-backward", "send-to-back")
+
+	elementDuplicateCmd.Flags().Float64Var(&elementDuplicateDX, "dx", 0, "Horizontal offset of the copy")
+	elementDuplicateCmd.Flags().Float64Var(&elementDuplicateDY, "dy", 0, "Vertical offset of the copy")
+	elementDuplicateCmd.Flags().StringVar(&elementDuplicateUnit, "unit", "PT", "Unit for offsets (PT, EMU, IN, CM)")
+	elementDuplicateCmd.Flags().IntVar(&elementDuplicateToSlide, "to-slide", 0, "Copy to another slide instead of the element's own slide")
+
+	elementCmd.AddCommand(elementMoveCmd)
+	elementCmd.AddCommand(elementResizeCmd)
+	elementCmd.AddCommand(elementRotateCmd)
+	elementCmd.AddCommand(elementZOrderCmd)
+	elementCmd.AddCommand(elementDeleteCmd)
+	elementCmd.AddCommand(elementDuplicateCmd)
+	rootCmd.AddCommand(elementCmd)
+
+	alignCmd.Flags().BoolVar(&alignLeft, "left", false, "Align left edges")
+	alignCmd.Flags().BoolVar(&alignCenter, "center", false, "Align horizontal centers")
+	alignCmd.Flags().BoolVar(&alignRight, "right", false, "Align right edges")
+	alignCmd.Flags().BoolVar(&alignTop, "top", false, "Align top edges")
+	alignCmd.Flags().BoolVar(&alignMiddle, "middle", false, "Align vertical centers")
+	alignCmd.Flags().BoolVar(&alignBottom, "bottom", false, "Align bottom edges")
+	alignCmd.Flags().StringVar(&alignRelativeTo, "relative-to", "selection", "Align relative to the slide or the selection")
+	alignCmd.MarkFlagsMutuallyExclusive("left", "center", "right", "top", "middle", "bottom")
+	alignCmd.MarkFlagsOneRequired("left", "center", "right", "top", "middle", "bottom")
+
+	distributeCmd.Flags().BoolVar(&distributeHorizontal, "horizontal", false, "Distribute horizontally")
+	distributeCmd.Flags().BoolVar(&distributeVertical, "vertical", false, "Distribute vertically")
+	distributeCmd.MarkFlagsMutuallyExclusive("horizontal", "vertical")
+	distributeCmd.MarkFlagsOneRequired("horizontal", "vertical")
+
+	rootCmd.AddCommand(groupCmd)
+	rootCmd.AddCommand(ungroupCmd)
+	rootCmd.AddCommand(alignCmd)
+	rootCmd.AddCommand(distributeCmd)
+}
+
+var elementCmd = &cobra.Command{
+	Use:   "element",
+	Short: "Move, resize, rotate, reorder, delete or duplicate page elements",
+}
+
+var elementMoveCmd = &cobra.Command{
+	Use:   "move <presentation-id> <object-id>",
+	Short: "Move an element to an absolute position or by an offset",
+	Args:  cobra.ExactArgs(2),
+	RunE:  runElementMove,
+}
+
+func runElementMove(cmd *cobra.Command, args []string) error {
+	ctx := context.Background()
+	presentationID := args[0]
+	objectID := args[1]
+
+	coords := []float64{elementMoveX, elementMoveY, elementMoveDX, elementMoveDY}
+	for i, v := range coords {
+		pt, err := geometry.ToPT(v, elementMoveUnit)
+		if err != nil {
+			return err
+		}
+		coords[i] = pt
+	}
+
+	var x, y *float64
+	if cmd.Flags().Changed("x") {
+		x = &coords[0]
+	}
+	if cmd.Flags().Changed("y") {
+		y = &coords[1]
+	}
+
+	slidesService, err := auth.GetSlidesService(ctx)
+	if err != nil {
+		return err
+	}
+
+	svc := element.NewService(ctx, slidesService)
+
+	if cmd.Flags().Changed("to-slide") {
+		mapping, err := svc.MoveToSlide(ctx, presentationID, objectID, elementMoveToSlide, x, y, coords[2], coords[3])
+		if err != nil {
+			return err
+		}
+
+		fmt.Fprintf(os.Stderr, "✅ Element moved to slide %d: %s -> %s\n", elementMoveToSlide, objectID, mapping[objectID])
+		return printJSON(mapping)
+	}
+
+	if err := svc.Move(ctx, presentationID, objectID, x, y, coords[2], coords[3]); err != nil {
+		return err
+	}
+
+	fmt.Fprintf(os.Stderr, "✅ Element moved: %s\n", objectID)
+	return nil
+}
+
+var elementResizeCmd = &cobra.Command{
+	Use:   "resize <presentation-id> <object-id>",
+	Short: "Resize an element to a width/height or by a scale factor",
+	Args:  cobra.ExactArgs(2),
+	RunE:  runElementResize,
+}
+
+func runElementResize(cmd *cobra.Command, args []string) error {
+	ctx := context.Background()
+	presentationID := args[0]
+	objectID := args[1]
+
+	var width, height *float64
+	if cmd.Flags().Changed("width") {
+		pt, err := geometry.ToPT(elementResizeWidth, elementResizeUnit)
+		if err != nil {
+			return err
+		}
+		width = &pt
+	}
+	if cmd.Flags().Changed("height") {
+		pt, err := geometry.ToPT(elementResizeHeight, elementResizeUnit)
+		if err != nil {
+			return err
+		}
+		height = &pt
 	}
 
-	cols, err := strconv.ParseInt(args[3], 10, 64)
-	if err != nil {
-		return fmt.Errorf("invalid cols: %w", err)
+	if width == nil && height == nil && !cmd.Flags().Changed("scale") {
+		return fmt.Errorf("one of --width, --height or --scale is required")
 	}
 
 	slidesService, err := auth.GetSlidesService(ctx)
@@ -296,80 +2327,68 @@ func runCreateTable(cmd *cobra.Command, args []string) error {
 		return err
 	}
 
-	svc := table.NewService(ctx, slidesService)
-	tableID, err := svc.Create(ctx, presentationID, slideIndex, rows, cols)
-	if err != nil {
+	svc := element.NewService(ctx, slidesService)
+	if err := svc.Resize(ctx, presentationID, objectID, width, height, elementResizeScale); err != nil {
 		return err
 	}
 
-	fmt.Fprintf(os.Stderr, "✅ Table created (%dx%d)\n", rows, cols)
-	fmt.Println(tableID)
-
+	fmt.Fprintf(os.Stderr, "✅ Element resized: %s\n", objectID)
 	return nil
 }
 
-var updateCellCmd = &cobra.Command{
-	Use:   "update-cell <presentation-id> <table-id> <row> <col> <text>",
-	Short: "Update table cell content",
-	Args:  cobra.ExactArgs(5),
-	RunE:  runUpdateCell,
+var elementRotateCmd = &cobra.Command{
+	Use:   "rotate <presentation-id> <object-id>",
+	Short: "Rotate an element around its center",
+	Args:  cobra.ExactArgs(2),
+	RunE:  runElementRotate,
 }
 
-func runUpdateCell(cmd *cobra.Command, args []string) error {
+func runElementRotate(cmd *cobra.Command, args []string) error {
 	ctx := context.Background()
 	presentationID := args[0]
-	tableID := args[1]
-
-	row, err := strconv.ParseInt(args[2], 10, 64)
-	if err != nil {
-		return fmt.Errorf("invalid row: %w", err)
-	}
+	objectID := args[1]
 
-	col, err := strconv.ParseInt(args[3], 10, 64)
-	if err != nil {
-		return fmt.Errorf("invalid col: %w", err)
+	var degrees *float64
+	if cmd.Flags().Changed("degrees") {
+		degrees = &elementRotateDegrees
 	}
 
-	textContent := args[4]
-
 	slidesService, err := auth.GetSlidesService(ctx)
 	if err != nil {
 		return err
 	}
 
-	svc := table.NewService(ctx, slidesService)
-	if err := svc.UpdateCell(ctx, presentationID, tableID, row, col, textContent); err != nil {
+	svc := element.NewService(ctx, slidesService)
+	if err := svc.Rotate(ctx, presentationID, objectID, degrees, elementRotateBy); err != nil {
 		return err
 	}
 
-	fmt.Fprintf(os.Stderr, "✅ Cell updated (row %d, col %d)\n", row, col)
+	fmt.Fprintf(os.Stderr, "✅ Element rotated: %s\n", objectID)
 	return nil
 }
 
-var styleCellCmd = &cobra.Command{
-	Use:   "style-cell <presentation-id> <table-id> <row> <col>",
-	Short: "Style table cell (background color)",
-	Args:  cobra.ExactArgs(4),
-	RunE:  runStyleCell,
+var elementZOrderCmd = &cobra.Command{
+	Use:   "z-order <presentation-id> <object-id>...",
+	Short: "Change the stacking order of elements",
+	Args:  cobra.MinimumNArgs(2),
+	RunE:  runElementZOrder,
 }
 
-func runStyleCell(cmd *cobra.Command, args []string) error {
+func runElementZOrder(cmd *cobra.Command, args []string) error {
 	ctx := context.Background()
 	presentationID := args[0]
-	tableID := args[1]
-
-	row, err := strconv.ParseInt(args[2], 10, 64)
-	if err != nil {
-		return fmt.Errorf("invalid row: %w", err)
-	}
-
-	col, err := strconv.ParseInt(args[3], 10, 64)
-	if err != nil {
-		return fmt.Errorf("invalid col: %w", err)
-	}
-
-	if styleCellBgColor == "" {
-		return fmt.Errorf("background color is required (--bg-color)")
+	objectIDs := args[1:]
+
+	var operation string
+	switch {
+	case elementZOrderFront:
+		operation = "BRING_TO_FRONT"
+	case elementZOrderForward:
+		operation = "BRING_FORWARD"
+	case elementZOrderBackward:
+		operation = "SEND_BACKWARD"
+	case elementZOrderBack:
+		operation = "SEND_TO_BACK"
 	}
 
 	slidesService, err := auth.GetSlidesService(ctx)
@@ -377,237 +2396,255 @@ func runStyleCell(cmd *cobra.Command, args []string) error {
 		return err
 	}
 
-	svc := table.NewService(ctx, slidesService)
-	if err := svc.StyleCell(ctx, presentationID, tableID, row, col, styleCellBgColor); err != nil {
+	svc := element.NewService(ctx, slidesService)
+	if err := svc.ZOrder(ctx, presentationID, objectIDs, operation); err != nil {
 		return err
 	}
 
-	fmt.Fprintf(os.Stderr, "✅ Cell styled (row %d, col %d)\n", row, col)
+	fmt.Fprintf(os.Stderr, "✅ Z-order updated (%s): %s\n", operation, strings.Join(objectIDs, ", "))
 	return nil
 }
 
-// ==================== Text Commands ====================
-
-func initTextCommands() {
-	rootCmd.AddCommand(replaceTextCmd)
-	rootCmd.AddCommand(extractAllTextCmd)
-	rootCmd.AddCommand(searchTextCmd)
-}
-
-var replaceTextCmd = &cobra.Command{
-	Use:   "replace-text <presentation-id> <find> <replace>",
-	Short: "Find and replace text in presentation",
-	Args:  cobra.ExactArgs(3),
-	RunE:  runReplaceText,
+var elementDeleteCmd = &cobra.Command{
+	Use:   "delete <presentation-id> <object-id>...",
+	Short: "Delete elements",
+	Args:  cobra.MinimumNArgs(2),
+	RunE:  runElementDelete,
 }
 
-func runReplaceText(cmd *cobra.Command, args []string) error {
+func runElementDelete(cmd *cobra.Command, args []string) error {
 	ctx := context.Background()
 	presentationID := args[0]
-	findText := args[1]
-	replaceText := args[2]
+	objectIDs := args[1:]
 
 	slidesService, err := auth.GetSlidesService(ctx)
 	if err != nil {
 		return err
 	}
 
-	svc := text.NewService(ctx, slidesService)
-	if err := svc.Replace(ctx, presentationID, findText, replaceText); err != nil {
+	svc := element.NewService(ctx, slidesService)
+	if err := svc.Delete(ctx, presentationID, objectIDs); err != nil {
 		return err
 	}
 
-	fmt.Fprintf(os.Stderr, "✅ Text replaced: '%s' -> '%s'\n", findText, replaceText)
+	fmt.Fprintf(os.Stderr, "✅ Deleted %d element(s)\n", len(objectIDs))
 	return nil
 }
 
-var extractAllTextCmd = &cobra.Command{
-	Use:   "extract-all-text <presentation-id>",
-	Short: "Extract all text from presentation",
-	Args:  cobra.ExactArgs(1),
-	RunE:  runExtractAllText,
+var elementDuplicateCmd = &cobra.Command{
+	Use:   "duplicate <presentation-id> <object-id>",
+	Short: "Duplicate an element and print the original-to-new ID mapping",
+	Args:  cobra.ExactArgs(2),
+	RunE:  runElementDuplicate,
 }
 
-func runExtractAllText(cmd *cobra.Command, args []string) error {
+func runElementDuplicate(cmd *cobra.Command, args []string) error {
 	ctx := context.Background()
 	presentationID := args[0]
+	objectID := args[1]
+
+	dx, err := geometry.ToPT(elementDuplicateDX, elementDuplicateUnit)
+	if err != nil {
+		return err
+	}
+	dy, err := geometry.ToPT(elementDuplicateDY, elementDuplicateUnit)
+	if err != nil {
+		return err
+	}
 
 	slidesService, err := auth.GetSlidesService(ctx)
 	if err != nil {
 		return err
 	}
 
-	svc := text.NewService(ctx, slidesService)
-	allText, err := svc.ExtractAll(ctx, presentationID)
+	svc := element.NewService(ctx, slidesService)
+
+	var mapping map[string]string
+	if cmd.Flags().Changed("to-slide") {
+		mapping, err = svc.CopyToSlide(ctx, presentationID, objectID, elementDuplicateToSlide, nil, nil, dx, dy)
+	} else {
+		mapping, err = svc.Duplicate(ctx, presentationID, objectID, dx, dy)
+	}
 	if err != nil {
 		return err
 	}
 
-	fmt.Println(allText)
-	return nil
+	fmt.Fprintf(os.Stderr, "✅ Element duplicated: %s -> %s\n", objectID, mapping[objectID])
+	return printJSON(mapping)
 }
 
-var searchTextCmd = &cobra.Command{
-	Use:   "search-text <presentation-id> <query>",
-	Short: "Search for text in presentation",
-	Args:  cobra.ExactArgs(2),
-	RunE:  runSearchText,
+var groupCmd = &cobra.Command{
+	Use:   "group <presentation-id> <object-id> <object-id>...",
+	Short: "Group elements of the same slide",
+	Args:  cobra.MinimumNArgs(3),
+	RunE:  runGroup,
 }
 
-func runSearchText(cmd *cobra.Command, args []string) error {
+func runGroup(cmd *cobra.Command, args []string) error {
 	ctx := context.Background()
 	presentationID := args[0]
-	query := args[1]
+	objectIDs := args[1:]
 
 	slidesService, err := auth.GetSlidesService(ctx)
 	if err != nil {
 		return err
 	}
 
-	svc := text.NewService(ctx, slidesService)
-	results, err := svc.Search(ctx, presentationID, query)
+	svc := element.NewService(ctx, slidesService)
+	groupID, err := svc.Group(ctx, presentationID, objectIDs)
 	if err != nil {
 		return err
 	}
 
-	return printJSON(results)
-}
-
-// ==================== Notes Commands ====================
+	fmt.Fprintf(os.Stderr, "✅ Grouped %d element(s)\n", len(objectIDs))
+	fmt.Println(groupID)
 
-func initNotesCommands() {
-	rootCmd.AddCommand(getNotesCmd)
-	rootCmd.AddCommand(addNotesCmd)
-	rootCmd.AddCommand(extractAllNotesCmd)
+	return nil
 }
 
-var getNotesCmd = &cobra.Command{
-	Use:   "get-notes <presentation-id> <slide-index>",
-	Short: "Get speaker notes from a slide",
-	Args:  cobra.ExactArgs(2),
-	RunE:  runGetNotes,
+var ungroupCmd = &cobra.Command{
+	Use:   "ungroup <presentation-id> <group-id>...",
+	Short: "Ungroup groups, keeping their elements",
+	Args:  cobra.MinimumNArgs(2),
+	RunE:  runUngroup,
 }
 
-func runGetNotes(cmd *cobra.Command, args []string) error {
+func runUngroup(cmd *cobra.Command, args []string) error {
 	ctx := context.Background()
 	presentationID := args[0]
-
-	slideIndex, err := strconv.Atoi(args[1])
-	if err != nil {
-		return fmt.Errorf("invalid slide index: %w", err)
-	}
+	groupIDs := args[1:]
 
 	slidesService, err := auth.GetSlidesService(ctx)
 	if err != nil {
 		return err
 	}
 
-	svc := notes.NewService(ctx, slidesService)
-	notesText, err := svc.Get(ctx, presentationID, slideIndex)
-	if err != nil {
+	svc := element.NewService(ctx, slidesService)
+	if err := svc.Ungroup(ctx, presentationID, groupIDs); err != nil {
 		return err
 	}
 
-	fmt.Println(notesText)
+	fmt.Fprintf(os.Stderr, "✅ Ungrouped: %s\n", strings.Join(groupIDs, ", "))
 	return nil
 }
 
-var addNotesCmd = &cobra.Command{
-	Use:   "add-notes <presentation-id> <slide-index> <notes>",
-	Short: "Add speaker notes to a slide",
-	Args:  cobra.ExactArgs(3),
-	RunE:  runAddNotes,
+var alignCmd = &cobra.Command{
+	Use:   "align <presentation-id> <object-id>...",
+	Short: "Align elements on an edge or center of the slide or selection",
+	Args:  cobra.MinimumNArgs(2),
+	RunE:  runAlign,
 }
 
-func runAddNotes(cmd *cobra.Command, args []string) error {
+func runAlign(cmd *cobra.Command, args []string) error {
 	ctx := context.Background()
 	presentationID := args[0]
-
-	slideIndex, err := strconv.Atoi(args[1])
-	if err != nil {
-		return fmt.Errorf("invalid slide index: %w", err)
+	objectIDs := args[1:]
+
+	var edge string
+	switch {
+	case alignLeft:
+		edge = "LEFT"
+	case alignCenter:
+		edge = "CENTER"
+	case alignRight:
+		edge = "RIGHT"
+	case alignTop:
+		edge = "TOP"
+	case alignMiddle:
+		edge = "MIDDLE"
+	case alignBottom:
+		edge = "BOTTOM"
 	}
 
-	notesContent := args[2]
-
 	slidesService, err := auth.GetSlidesService(ctx)
 	if err != nil {
 		return err
 	}
 
-	svc := notes.NewService(ctx, slidesService)
-	if err := svc.Add(ctx, presentationID, slideIndex, notesContent); err != nil {
+	svc := element.NewService(ctx, slidesService)
+	if err := svc.Align(ctx, presentationID, objectIDs, edge, alignRelativeTo); err != nil {
 		return err
 	}
 
-	fmt.Fprintf(os.Stderr, "✅ Notes added to slide %d\n", slideIndex)
+	fmt.Fprintf(os.Stderr, "✅ Aligned %d element(s) (%s)\n", len(objectIDs), edge)
 	return nil
 }
 
-var extractAllNotesCmd = &cobra.Command{
-	Use:   "extract-all-notes <presentation-id>",
-	Short: "Extract all speaker notes from presentation",
-	Args:  cobra.ExactArgs(1),
-	RunE:  runExtractAllNotes,
+var distributeCmd = &cobra.Command{
+	Use:   "distribute <presentation-id> <object-id> <object-id> <object-id>...",
+	Short: "Space elements evenly between the outermost ones",
+	Args:  cobra.MinimumNArgs(4),
+	RunE:  runDistribute,
 }
 
-func runExtractAllNotes(cmd *cobra.Command, args []string) error {
+func runDistribute(cmd *cobra.Command, args []string) error {
 	ctx := context.Background()
 	presentationID := args[0]
+	objectIDs := args[1:]
+
+	direction := element.Horizontal
+	if distributeVertical {
+		direction = element.Vertical
+	}
 
 	slidesService, err := auth.GetSlidesService(ctx)
 	if err != nil {
 		return err
 	}
 
-	svc := notes.NewService(ctx, slidesService)
-	allNotes, err := svc.ExtractAll(ctx, presentationID)
-	if err != nil {
+	svc := element.NewService(ctx, slidesService)
+	if err := svc.Distribute(ctx, presentationID, objectIDs, direction); err != nil {
 		return err
 	}
 
-	return printJSON(allNotes)
+	fmt.Fprintf(os.Stderr, "✅ Distributed %d element(s) %s\n", len(objectIDs), strings.ToLower(direction))
+	return nil
 }
 
-// ==================== Shape Commands ====================
+// ==================== Inspect Commands ====================
 
-func initShapeCommands() {
-	rootCmd.AddCommand(addShapeCmd)
+func initInspectCommands() {
+	inspectCmd.Flags().IntVar(&inspectSlide, "slide", 0, "Only inspect this slide index")
+	inspectCmd.Flags().StringVar(&inspectFormat, "format", "table", "Output format: table or json")
+	rootCmd.AddCommand(inspectCmd)
 }
 
-var addShapeCmd = &cobra.Command{
-	Use:   "add-shape <presentation-id> <slide-index> <shape-type>",
-	Short: "Add a shape to a slide (RECTANGLE, ELLIPSE, etc.)",
-	Args:  cobra.ExactArgs(3),
-	RunE:  runAddShape,
+var inspectCmd = &cobra.Command{
+	Use:   "inspect <presentation-id>",
+	Short: "Print the element tree of slides with object IDs and absolute geometry",
+	Args:  cobra.ExactArgs(1),
+	RunE:  runInspect,
 }
 
-func runAddShape(cmd *cobra.Command, args []string) error {
+func runInspect(cmd *cobra.Command, args []string) error {
 	ctx := context.Background()
 	presentationID := args[0]
 
-	slideIndex, err := strconv.Atoi(args[1])
-	if err != nil {
-		return fmt.Errorf("invalid slide index: %w", err)
+	format := strings.ToLower(inspectFormat)
+	if format != "table" && format != "json" {
+		return fmt.Errorf("invalid format %q (expected table or json)", inspectFormat)
 	}
 
-	shapeType := args[2]
+	var slideIndex *int
+	if cmd.Flags().Changed("slide") {
+		slideIndex = &inspectSlide
+	}
 
 	slidesService, err := auth.GetSlidesService(ctx)
 	if err != nil {
 		return err
 	}
 
-	svc := shape.NewService(ctx, slidesService)
-	shapeID, err := svc.Add(ctx, presentationID, slideIndex, shapeType)
+	svc := inspect.NewService(ctx, slidesService)
+	result, err := svc.Inspect(ctx, presentationID, slideIndex)
 	if err != nil {
 		return err
 	}
 
-	fmt.Fprintf(os.Stderr, "✅ Shape added: %s\n", shapeType)
-	fmt.Println(shapeID)
-
-	return nil
+	if format == "json" {
+		return printJSON(result)
+	}
+	return inspect.WriteTable(os.Stdout, result)
 }
 
 // ==================== Style Commands ====================
@@ -700,64 +2737,342 @@ func runTranslateSlides(cmd *cobra.Command, args []string) error {
 // ==================== Export Commands ====================
 
 func initExportCommands() {
+	exportScriptCmd.Flags().StringVar(&exportScriptFormat, "format", export.ScriptMarkdown, "Output format: md, html or txt")
+	exportScriptCmd.Flags().IntVar(&exportScriptWPM, "wpm", export.DefaultWPM, "Speaking rate in words per minute used to estimate timings")
+	exportScriptCmd.Flags().StringVar(&exportScriptThumbnails, "thumbnails", "thumbnails", "Directory referenced for slide thumbnails (slide_001.png, ...)")
+	exportScriptCmd.Flags().StringVarP(&exportScriptOutput, "output", "o", "", "Output file (default: stdout)")
+	exportImagesCmd.Flags().StringVar(&exportImagesSlides, "slides", "", "Slide numbers to export, starting at 1, e.g. 1-5,8 (default: all)")
+	exportImagesCmd.Flags().StringVar(&exportImagesSize, "size", "LARGE", "Thumbnail size: SMALL, MEDIUM or LARGE")
+	exportImagesCmd.Flags().StringVar(&exportImagesFormat, "format", "png", "Image format: png or jpeg")
+	exportImagesCmd.Flags().StringVar(&exportImagesTemplate, "template", export.DefaultThumbnailTemplate, "File name template with {index} (1-based slide number), {id}, {title-slug} and {ext}, e.g. {index:03}-{title-slug}")
+	exportImagesCmd.Flags().IntVar(&exportImagesConcurrency, "concurrency", 4, "Maximum number of parallel downloads")
+	exportImagesCmd.Flags().Float64Var(&exportImagesRate, "rate", 1, "Maximum thumbnail requests per second (0 for no limit)")
+	exportCmd.Flags().StringVar(&exportFormat, "format", "", "Export format: "+strings.Join(export.FormatNames(), ", ")+" (default: from the output extension, else pdf)")
+	for _, cmd := range []*cobra.Command{exportCmd, exportPdfCmd, exportPptxCmd} {
+		cmd.Flags().StringVar(&exportSlides, "slides", "", "Slide numbers to export, starting at 1, e.g. 3-7,10 (default: all)")
+	}
+	rootCmd.AddCommand(exportCmd)
 	rootCmd.AddCommand(exportPdfCmd)
 	rootCmd.AddCommand(exportPptxCmd)
+	rootCmd.AddCommand(exportScriptCmd)
+	rootCmd.AddCommand(exportImagesCmd)
+}
+
+var exportCmd = &cobra.Command{
+	Use:   "export <presentation-id> <output-file|->",
+	Short: "Export presentation to a file or stdout (pdf, pptx, odp, txt, jpeg, png, svg)",
+	Args:  cobra.ExactArgs(2),
+	RunE:  runExport,
+}
+
+func runExport(cmd *cobra.Command, args []string) error {
+	format := exportFormat
+	if format == "" {
+		format = "pdf"
+		if inferred, ok := export.FormatFromPath(args[1]); ok && args[1] != export.Stdout {
+			format = inferred
+		}
+	}
+
+	return exportPresentation(args[0], format, args[1])
 }
 
 var exportPdfCmd = &cobra.Command{
-	Use:   "export-pdf <presentation-id> <output-file>",
+	Use:   "export-pdf <presentation-id> <output-file|->",
 	Short: "Export presentation as PDF",
 	Args:  cobra.ExactArgs(2),
 	RunE:  runExportPdf,
 }
 
 func runExportPdf(cmd *cobra.Command, args []string) error {
+	return exportPresentation(args[0], "pdf", args[1])
+}
+
+var exportPptxCmd = &cobra.Command{
+	Use:   "export-pptx <presentation-id> <output-file|->",
+	Short: "Export presentation as PowerPoint",
+	Args:  cobra.ExactArgs(2),
+	RunE:  runExportPptx,
+}
+
+func runExportPptx(cmd *cobra.Command, args []string) error {
+	return exportPresentation(args[0], "pptx", args[1])
+}
+
+// exportPresentation exports a presentation through Drive and reports the
+// MIME type and size on stderr, keeping stdout free for streamed output. An
+// interrupt cancels the export instead of killing the process, so the
+// temporary copy made for --slides is always deleted.
+func exportPresentation(presentationID string, format string, output string) error {
+	var slideIndices []int64
+	if exportSlides != "" {
+		indices, err := parseSlideList(exportSlides)
+		if err != nil {
+			return err
+		}
+		slideIndices = indices
+	}
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	slidesService, err := auth.GetSlidesService(ctx)
+	if err != nil {
+		return err
+	}
+
+	driveService, err := auth.GetDriveService(ctx)
+	if err != nil {
+		return err
+	}
+
+	svc := export.NewService(ctx, slidesService, driveService)
+	result, err := svc.Export(ctx, presentationID, format, output, slideIndices)
+	if err != nil {
+		return err
+	}
+
+	destination := result.Output
+	if destination == export.Stdout {
+		destination = "stdout"
+	}
+	fmt.Fprintf(os.Stderr, "✅ Presentation exported as %s: %s (%s, %d bytes)\n", strings.ToUpper(result.Format), destination, result.MimeType, result.Bytes)
+	return nil
+}
+
+var exportScriptCmd = &cobra.Command{
+	Use:   "export-script <presentation-id>",
+	Short: "Export a speaker script with titles, thumbnails, notes and timings",
+	Args:  cobra.ExactArgs(1),
+	RunE:  runExportScript,
+}
+
+func runExportScript(cmd *cobra.Command, args []string) error {
 	ctx := context.Background()
 	presentationID := args[0]
-	outputFile := args[1]
 
-	driveService, err := auth.GetDriveService(ctx)
+	format := strings.ToLower(exportScriptFormat)
+	if format == "markdown" {
+		format = export.ScriptMarkdown
+	}
+	if format != export.ScriptMarkdown && format != export.ScriptHTML && format != export.ScriptText {
+		return fmt.Errorf("invalid format %q (expected md, html or txt)", exportScriptFormat)
+	}
+
+	slidesService, err := auth.GetSlidesService(ctx)
+	if err != nil {
+		return err
+	}
+
+	notesSvc := notes.NewService(ctx, slidesService)
+	allNotes, err := notesSvc.ExtractAll(ctx, presentationID)
 	if err != nil {
 		return err
 	}
 
-	svc := export.NewService(ctx, driveService)
-	if err := svc.ToPDF(ctx, presentationID, outputFile); err != nil {
+	script, err := export.BuildScript(allNotes, exportScriptWPM, exportScriptThumbnails)
+	if err != nil {
 		return err
 	}
 
-	fmt.Fprintf(os.Stderr, "✅ Presentation exported as PDF: %s\n", outputFile)
+	if exportScriptOutput == "" {
+		return export.WriteScript(os.Stdout, script, format)
+	}
+
+	err = export.WriteAtomic(exportScriptOutput, func(w io.Writer) error {
+		return export.WriteScript(w, script, format)
+	})
+	if err != nil {
+		return fmt.Errorf("error writing script: %w", err)
+	}
+
+	fmt.Fprintf(os.Stderr, "✅ Script exported: %s (%d slides, %d:%02d)\n", exportScriptOutput, len(script.Slides), script.TotalSeconds/60, script.TotalSeconds%60)
 	return nil
 }
 
-var exportPptxCmd = &cobra.Command{
-	Use:   "export-pptx <presentation-id> <output-file>",
-	Short: "Export presentation as PowerPoint",
+var exportImagesCmd = &cobra.Command{
+	Use:   "export-images <presentation-id> <output-dir>",
+	Short: "Export slides as PNG or JPEG images",
 	Args:  cobra.ExactArgs(2),
-	RunE:  runExportPptx,
+	RunE:  runExportImages,
 }
 
-func runExportPptx(cmd *cobra.Command, args []string) error {
+func runExportImages(cmd *cobra.Command, args []string) error {
 	ctx := context.Background()
 	presentationID := args[0]
-	outputFile := args[1]
+	outputDir := args[1]
+
+	opts := export.ThumbnailOptions{
+		Size:        exportImagesSize,
+		Format:      exportImagesFormat,
+		Template:    exportImagesTemplate,
+		Concurrency: exportImagesConcurrency,
+		Rate:        exportImagesRate,
+	}
+
+	if exportImagesSlides != "" {
+		indices, err := parseSlideList(exportImagesSlides)
+		if err != nil {
+			return err
+		}
+		opts.SlideIndices = indices
+	}
+
+	slidesService, err := auth.GetSlidesService(ctx)
+	if err != nil {
+		return err
+	}
 
 	driveService, err := auth.GetDriveService(ctx)
 	if err != nil {
 		return err
 	}
 
-	svc := export.NewService(ctx, driveService)
-	if err := svc.ToPPTX(ctx, presentationID, outputFile); err != nil {
+	svc := export.NewService(ctx, slidesService, driveService)
+	thumbnails, err := svc.ExportThumbnails(ctx, presentationID, outputDir, opts)
+	if err != nil {
 		return err
 	}
 
-	fmt.Fprintf(os.Stderr, "✅ Presentation exported as PPTX: %s\n", outputFile)
+	failed := 0
+	for _, t := range thumbnails {
+		if t.Error != "" {
+			fmt.Fprintf(os.Stderr, "❌ Slide %d: %s\n", t.Slide, t.Error)
+			failed++
+			continue
+		}
+		fmt.Println(t.Path)
+	}
+
+	if failed > 0 {
+		return fmt.Errorf("%d of %d slides failed to export", failed, len(thumbnails))
+	}
+
+	fmt.Fprintf(os.Stderr, "✅ Exported %d slide images to %s\n", len(thumbnails), outputDir)
 	return nil
 }
 
 // ==================== Helper Functions ====================
 
+// parseTextTarget builds a text target from --range, --match and --cell values.
+func parseTextTarget(rangeValue string, match string, cellValue string) (text.Target, error) {
+	target := text.Target{Match: match}
+
+	if rangeValue != "" && match != "" {
+		return target, fmt.Errorf("--range and --match cannot be combined")
+	}
+
+	if rangeValue != "" {
+		start, end, err := parseTextRange(rangeValue)
+		if err != nil {
+			return target, err
+		}
+		target.Start = start
+		target.End = end
+	}
+
+	if cellValue != "" {
+		cell, err := parseCellLocation(cellValue)
+		if err != nil {
+			return target, err
+		}
+		target.Cell = cell
+	}
+
+	return target, nil
+}
+
+// parseTextRange parses a "start:end" text range; either side may be empty.
+func parseTextRange(value string) (*int64, *int64, error) {
+	startStr, endStr, ok := strings.Cut(value, ":")
+	if !ok {
+		return nil, nil, fmt.Errorf("invalid range %q: expected start:end", value)
+	}
+
+	var start, end *int64
+	if startStr = strings.TrimSpace(startStr); startStr != "" {
+		v, err := strconv.ParseInt(startStr, 10, 64)
+		if err != nil || v < 0 {
+			return nil, nil, fmt.Errorf("invalid range start %q", startStr)
+		}
+		start = &v
+	}
+	if endStr = strings.TrimSpace(endStr); endStr != "" {
+		v, err := strconv.ParseInt(endStr, 10, 64)
+		if err != nil || v < 0 {
+			return nil, nil, fmt.Errorf("invalid range end %q", endStr)
+		}
+		end = &v
+	}
+
+	return start, end, nil
+}
+
+// parseCellLocation parses a "row,col" table cell location.
+func parseCellLocation(value string) (*slides.TableCellLocation, error) {
+	rowStr, colStr, ok := strings.Cut(value, ",")
+	if !ok {
+		return nil, fmt.Errorf("invalid cell %q: expected row,col", value)
+	}
+
+	row, err := strconv.ParseInt(strings.TrimSpace(rowStr), 10, 64)
+	if err != nil || row < 0 {
+		return nil, fmt.Errorf("invalid cell row %q", rowStr)
+	}
+
+	col, err := strconv.ParseInt(strings.TrimSpace(colStr), 10, 64)
+	if err != nil || col < 0 {
+		return nil, fmt.Errorf("invalid cell column %q", colStr)
+	}
+
+	return &slides.TableCellLocation{RowIndex: row, ColumnIndex: col}, nil
+}
+
+// parseIndexList parses comma-separated indices and inclusive ranges, e.g. "0,2,4-6".
+func parseIndexList(value string) ([]int64, error) {
+	var indices []int64
+	for _, part := range strings.Split(value, ",") {
+		part = strings.TrimSpace(part)
+		fromStr, toStr, isRange := strings.Cut(part, "-")
+		if !isRange {
+			toStr = fromStr
+		}
+
+		from, err := strconv.ParseInt(strings.TrimSpace(fromStr), 10, 64)
+		if err != nil || from < 0 {
+			return nil, fmt.Errorf("invalid index %q", part)
+		}
+
+		to, err := strconv.ParseInt(strings.TrimSpace(toStr), 10, 64)
+		if err != nil || to < from {
+			return nil, fmt.Errorf("invalid index range %q", part)
+		}
+
+		for i := from; i <= to; i++ {
+			indices = append(indices, i)
+		}
+	}
+	return indices, nil
+}
+
+// parseSlideList parses 1-based slide numbers and ranges such as "3-7,10"
+// into 0-based slide indices.
+func parseSlideList(value string) ([]int64, error) {
+	numbers, err := parseIndexList(value)
+	if err != nil {
+		return nil, err
+	}
+
+	indices := make([]int64, len(numbers))
+	for i, number := range numbers {
+		if number == 0 {
+			return nil, fmt.Errorf("invalid slide number 0 in %q (slides are numbered from 1)", value)
+		}
+		indices[i] = number - 1
+	}
+	return indices, nil
+}
+
 func printJSON(v interface{}) error {
 	data, err := json.MarshalIndent(v, "", "  ")
 	if err != nil {
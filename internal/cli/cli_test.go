@@ -0,0 +1,76 @@
+package cli
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestParseIndexList(t *testing.T) {
+	tests := []struct {
+		value   string
+		want    []int64
+		wantErr bool
+	}{
+		{value: "3", want: []int64{3}},
+		{value: "0,2,4", want: []int64{0, 2, 4}},
+		{value: "4-6", want: []int64{4, 5, 6}},
+		{value: "0, 2 , 4-6", want: []int64{0, 2, 4, 5, 6}},
+		{value: "2-2", want: []int64{2}},
+		{value: "", wantErr: true},
+		{value: "a", wantErr: true},
+		{value: "1,,2", wantErr: true},
+		{value: "-1", wantErr: true},
+		{value: "5-3", wantErr: true},
+		{value: "1-x", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.value, func(t *testing.T) {
+			got, err := parseIndexList(tt.value)
+			if tt.wantErr {
+				if err == nil {
+					t.Fatalf("parseIndexList(%q) = %v, want error", tt.value, got)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("parseIndexList(%q): %v", tt.value, err)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("parseIndexList(%q) = %v, want %v", tt.value, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestParseSlideList(t *testing.T) {
+	tests := []struct {
+		value   string
+		want    []int64
+		wantErr bool
+	}{
+		{value: "1", want: []int64{0}},
+		{value: "3-7,10", want: []int64{2, 3, 4, 5, 6, 9}},
+		{value: "0", wantErr: true},
+		{value: "0-2", wantErr: true},
+		{value: "x", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.value, func(t *testing.T) {
+			got, err := parseSlideList(tt.value)
+			if tt.wantErr {
+				if err == nil {
+					t.Fatalf("parseSlideList(%q) = %v, want error", tt.value, got)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("parseSlideList(%q): %v", tt.value, err)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("parseSlideList(%q) = %v, want %v", tt.value, got, tt.want)
+			}
+		})
+	}
+}
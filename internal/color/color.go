@@ -0,0 +1,120 @@
+package color
+
+import (
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+
+	"google.golang.org/api/slides/v1"
+)
+
+// ThemeColors lists the theme color types accepted by Parse.
+var ThemeColors = []string{
+	"DARK1", "LIGHT1", "DARK2", "LIGHT2",
+	"ACCENT1", "ACCENT2", "ACCENT3", "ACCENT4", "ACCENT5", "ACCENT6",
+	"HYPERLINK", "FOLLOWED_HYPERLINK",
+	"TEXT1", "BACKGROUND1", "TEXT2", "BACKGROUND2",
+}
+
+// names maps the accepted color names to their hex value.
+var names = map[string]string{
+	"black":   "#000000",
+	"white":   "#FFFFFF",
+	"gray":    "#808080",
+	"grey":    "#808080",
+	"silver":  "#C0C0C0",
+	"red":     "#FF0000",
+	"maroon":  "#800000",
+	"orange":  "#FFA500",
+	"gold":    "#FFD700",
+	"yellow":  "#FFFF00",
+	"olive":   "#808000",
+	"lime":    "#00FF00",
+	"green":   "#008000",
+	"teal":    "#008080",
+	"cyan":    "#00FFFF",
+	"aqua":    "#00FFFF",
+	"blue":    "#0000FF",
+	"navy":    "#000080",
+	"indigo":  "#4B0082",
+	"purple":  "#800080",
+	"violet":  "#EE82EE",
+	"magenta": "#FF00FF",
+	"fuchsia": "#FF00FF",
+	"pink":    "#FFC0CB",
+	"brown":   "#A52A2A",
+}
+
+// Names returns the accepted color names in alphabetical order.
+func Names() []string {
+	list := make([]string, 0, len(names))
+	for name := range names {
+		list = append(list, name)
+	}
+	slices.Sort(list)
+	return list
+}
+
+// Parse converts a color to an OpaqueColor. It accepts #RGB, #RRGGBB, color
+// names (see Names) and theme colors (see ThemeColors). Colors with an alpha
+// channel are rejected; use ParseAlpha where transparency is supported.
+func Parse(value string) (*slides.OpaqueColor, error) {
+	c, alpha, err := ParseAlpha(value)
+	if err != nil {
+		return nil, err
+	}
+
+	if alpha != 1 {
+		return nil, fmt.Errorf("invalid color %q: transparency is not supported here", value)
+	}
+
+	return c, nil
+}
+
+// ParseAlpha converts a color to an OpaqueColor and an alpha between 0 and 1.
+// In addition to the formats of Parse, it accepts #RRGGBBAA.
+func ParseAlpha(value string) (*slides.OpaqueColor, float64, error) {
+	trimmed := strings.TrimSpace(value)
+
+	if slices.Contains(ThemeColors, strings.ToUpper(trimmed)) {
+		return &slides.OpaqueColor{ThemeColor: strings.ToUpper(trimmed)}, 1, nil
+	}
+
+	if hex, ok := names[strings.ToLower(trimmed)]; ok {
+		trimmed = hex
+	}
+
+	if !strings.HasPrefix(trimmed, "#") {
+		return nil, 0, fmt.Errorf("invalid color %q: expected #RGB, #RRGGBB, #RRGGBBAA, a color name (%s) or a theme color (%s)",
+			value, strings.Join(Names(), ", "), strings.Join(ThemeColors, ", "))
+	}
+
+	hex := trimmed[1:]
+	if len(hex) == 3 {
+		hex = string([]byte{hex[0], hex[0], hex[1], hex[1], hex[2], hex[2]})
+	}
+
+	if len(hex) == 6 {
+		hex += "FF"
+	}
+
+	if len(hex) != 8 {
+		return nil, 0, fmt.Errorf("invalid color %q: expected #RGB, #RRGGBB or #RRGGBBAA", value)
+	}
+
+	rgba, err := strconv.ParseUint(hex, 16, 32)
+	if err != nil {
+		return nil, 0, fmt.Errorf("invalid color %q: not a hexadecimal value", value)
+	}
+
+	c := &slides.OpaqueColor{
+		RgbColor: &slides.RgbColor{
+			Red:   float64((rgba>>24)&0xFF) / 255.0,
+			Green: float64((rgba>>16)&0xFF) / 255.0,
+			Blue:  float64((rgba>>8)&0xFF) / 255.0,
+		},
+	}
+
+	return c, float64(rgba&0xFF) / 255.0, nil
+}
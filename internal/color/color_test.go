@@ -0,0 +1,76 @@
+package color
+
+import (
+	"math"
+	"testing"
+)
+
+func TestParseAlpha(t *testing.T) {
+	tests := []struct {
+		value            string
+		red, green, blue float64
+		theme            string
+		alpha            float64
+		wantErr          bool
+	}{
+		{value: "#FF0000", red: 1, alpha: 1},
+		{value: "#00ff00", green: 1, alpha: 1},
+		{value: "#00F", blue: 1, alpha: 1},
+		{value: " #FFFFFF ", red: 1, green: 1, blue: 1, alpha: 1},
+		{value: "#0000FF80", blue: 1, alpha: 128.0 / 255},
+		{value: "#00000000", alpha: 0},
+		{value: "navy", blue: 128.0 / 255, alpha: 1},
+		{value: "White", red: 1, green: 1, blue: 1, alpha: 1},
+		{value: "ACCENT1", theme: "ACCENT1", alpha: 1},
+		{value: "dark2", theme: "DARK2", alpha: 1},
+		{value: "FF0000", wantErr: true},
+		{value: "#FFFF", wantErr: true},
+		{value: "#GGGGGG", wantErr: true},
+		{value: "chartreuse", wantErr: true},
+		{value: "", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.value, func(t *testing.T) {
+			c, alpha, err := ParseAlpha(tt.value)
+			if tt.wantErr {
+				if err == nil {
+					t.Fatalf("ParseAlpha(%q) succeeded, want error", tt.value)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("ParseAlpha(%q): %v", tt.value, err)
+			}
+
+			if math.Abs(alpha-tt.alpha) > 1e-9 {
+				t.Errorf("alpha = %v, want %v", alpha, tt.alpha)
+			}
+
+			if tt.theme != "" {
+				if c.ThemeColor != tt.theme || c.RgbColor != nil {
+					t.Errorf("color = %+v, want theme color %s", c, tt.theme)
+				}
+				return
+			}
+
+			if c.RgbColor == nil {
+				t.Fatalf("color = %+v, want an RGB color", c)
+			}
+			rgb := c.RgbColor
+			if math.Abs(rgb.Red-tt.red) > 1e-9 || math.Abs(rgb.Green-tt.green) > 1e-9 || math.Abs(rgb.Blue-tt.blue) > 1e-9 {
+				t.Errorf("rgb = (%v, %v, %v), want (%v, %v, %v)", rgb.Red, rgb.Green, rgb.Blue, tt.red, tt.green, tt.blue)
+			}
+		})
+	}
+}
+
+func TestParseRejectsTransparency(t *testing.T) {
+	if _, err := Parse("#FF000080"); err == nil {
+		t.Error("Parse(#FF000080) succeeded, want error")
+	}
+
+	if _, err := Parse("#FF0000FF"); err != nil {
+		t.Errorf("Parse(#FF0000FF): %v", err)
+	}
+}
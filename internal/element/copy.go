@@ -0,0 +1,436 @@
+package element
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"google.golang.org/api/slides/v1"
+
+	"google-slide-manager/internal/geometry"
+	"google-slide-manager/internal/text"
+)
+
+// CopyToSlide copies an element to another slide and returns the mapping from original to new
+// object IDs. The Slides API cannot move elements between pages, so the copy
+// is rebuilt from the element's properties: shapes (text, text and paragraph
+// styles, fill and outline), images, lines, tables (text, column widths and
+// merges), videos, Sheets charts and groups of those. Position arguments
+// behave as in Move: the copy keeps the original position unless moved.
+func (s *Service) CopyToSlide(ctx context.Context, presentationID string, objectID string, slideIndex int, x *float64, y *float64, dx float64, dy float64) (map[string]string, error) {
+	return s.copyToSlide(presentationID, objectID, slideIndex, x, y, dx, dy, false)
+}
+
+// MoveToSlide copies an element to another slide like CopyToSlide and deletes
+// the original in the same batch.
+func (s *Service) MoveToSlide(ctx context.Context, presentationID string, objectID string, slideIndex int, x *float64, y *float64, dx float64, dy float64) (map[string]string, error) {
+	return s.copyToSlide(presentationID, objectID, slideIndex, x, y, dx, dy, true)
+}
+
+func (s *Service) copyToSlide(presentationID string, objectID string, slideIndex int, x *float64, y *float64, dx float64, dy float64, deleteOriginal bool) (map[string]string, error) {
+	presentation, err := s.slidesService.Presentations.Get(presentationID).Do()
+	if err != nil {
+		return nil, fmt.Errorf("error getting presentation: %w", err)
+	}
+
+	if slideIndex < 0 || slideIndex >= len(presentation.Slides) {
+		return nil, fmt.Errorf("slide index out of range")
+	}
+
+	placement, ok := geometry.Locate(presentation, objectID)
+	if !ok {
+		return nil, fmt.Errorf("element %s not found", objectID)
+	}
+
+	bounds := placement.Bounds()
+	if x != nil {
+		dx = *x - bounds.X
+	}
+	if y != nil {
+		dy = *y - bounds.Y
+	}
+
+	c := &copier{
+		pageObjectID: presentation.Slides[slideIndex].ObjectId,
+		base:         generateObjectID("copy"),
+		mapping:      map[string]string{},
+	}
+
+	if err := c.copyElement(placement.Element, geometry.Translation(dx, dy).Multiply(placement.Absolute)); err != nil {
+		return nil, err
+	}
+
+	requests := c.requests
+	if deleteOriginal {
+		requests = append(requests, &slides.Request{
+			DeleteObject: &slides.DeleteObjectRequest{ObjectId: objectID},
+		})
+	}
+
+	_, err = s.slidesService.Presentations.BatchUpdate(presentationID, &slides.BatchUpdatePresentationRequest{
+		Requests: requests,
+	}).Do()
+
+	if err != nil {
+		return nil, fmt.Errorf("error copying element: %w", err)
+	}
+
+	return c.mapping, nil
+}
+
+// copier accumulates the requests recreating elements on a page.
+type copier struct {
+	pageObjectID string
+	base         string
+	mapping      map[string]string
+	requests     []*slides.Request
+}
+
+// newID assigns a new object ID to an original object ID.
+func (c *copier) newID(objectID string) string {
+	id := fmt.Sprintf("%s_%d", c.base, len(c.mapping))
+	c.mapping[objectID] = id
+	return id
+}
+
+// properties places a copied element with the given absolute transform.
+func (c *copier) properties(element *slides.PageElement, absolute geometry.Matrix) *slides.PageElementProperties {
+	return &slides.PageElementProperties{
+		PageObjectId: c.pageObjectID,
+		Size:         copySize(element.Size),
+		Transform:    absolute.Transform(),
+	}
+}
+
+func (c *copier) add(request *slides.Request) {
+	c.requests = append(c.requests, request)
+}
+
+// copyElement appends the requests recreating element at the absolute transform.
+func (c *copier) copyElement(element *slides.PageElement, absolute geometry.Matrix) error {
+	switch {
+	case element.ElementGroup != nil:
+		return c.copyGroup(element, absolute)
+	case element.Shape != nil:
+		return c.copyShape(element, absolute)
+	case element.Image != nil:
+		c.copyImage(element, absolute)
+	case element.Line != nil:
+		c.copyLine(element, absolute)
+	case element.Table != nil:
+		c.copyTable(element, absolute)
+	case element.Video != nil:
+		c.add(&slides.Request{
+			CreateVideo: &slides.CreateVideoRequest{
+				ObjectId:          c.newID(element.ObjectId),
+				Source:            element.Video.Source,
+				Id:                element.Video.Id,
+				ElementProperties: c.properties(element, absolute),
+			},
+		})
+	case element.SheetsChart != nil:
+		c.add(&slides.Request{
+			CreateSheetsChart: &slides.CreateSheetsChartRequest{
+				ObjectId:          c.newID(element.ObjectId),
+				SpreadsheetId:     element.SheetsChart.SpreadsheetId,
+				ChartId:           element.SheetsChart.ChartId,
+				LinkingMode:       "LINKED",
+				ElementProperties: c.properties(element, absolute),
+				ForceSendFields:   []string{"ChartId"},
+			},
+		})
+	default:
+		return fmt.Errorf("cannot copy element %s: unsupported element type", element.ObjectId)
+	}
+	return nil
+}
+
+func (c *copier) copyGroup(element *slides.PageElement, absolute geometry.Matrix) error {
+	groupID := c.newID(element.ObjectId)
+
+	var childIDs []string
+	for _, child := range element.ElementGroup.Children {
+		if err := c.copyElement(child, absolute.Multiply(geometry.FromTransform(child.Transform))); err != nil {
+			return err
+		}
+		childIDs = append(childIDs, c.mapping[child.ObjectId])
+	}
+
+	c.add(&slides.Request{
+		GroupObjects: &slides.GroupObjectsRequest{
+			GroupObjectId:     groupID,
+			ChildrenObjectIds: childIDs,
+		},
+	})
+	return nil
+}
+
+func (c *copier) copyShape(element *slides.PageElement, absolute geometry.Matrix) error {
+	shape := element.Shape
+	if shape.ShapeType == "" || shape.ShapeType == "CUSTOM" || shape.ShapeType == "TYPE_UNSPECIFIED" {
+		return fmt.Errorf("cannot copy element %s: shape type %q cannot be created through the API", element.ObjectId, shape.ShapeType)
+	}
+
+	shapeID := c.newID(element.ObjectId)
+	c.add(&slides.Request{
+		CreateShape: &slides.CreateShapeRequest{
+			ObjectId:          shapeID,
+			ShapeType:         shape.ShapeType,
+			ElementProperties: c.properties(element, absolute),
+		},
+	})
+
+	if sp := shape.ShapeProperties; sp != nil {
+		properties := &slides.ShapeProperties{}
+		var fields []string
+		if sp.ShapeBackgroundFill != nil {
+			properties.ShapeBackgroundFill = sp.ShapeBackgroundFill
+			fields = append(fields, "shapeBackgroundFill")
+		}
+		if sp.Outline != nil {
+			properties.Outline = sp.Outline
+			fields = append(fields, "outline")
+		}
+		if sp.ContentAlignment == "TOP" || sp.ContentAlignment == "MIDDLE" || sp.ContentAlignment == "BOTTOM" {
+			properties.ContentAlignment = sp.ContentAlignment
+			fields = append(fields, "contentAlignment")
+		}
+		if sp.Link != nil {
+			properties.Link = sp.Link
+			fields = append(fields, "link")
+		}
+		if len(fields) > 0 {
+			c.add(&slides.Request{
+				UpdateShapeProperties: &slides.UpdateShapePropertiesRequest{
+					ObjectId:        shapeID,
+					ShapeProperties: properties,
+					Fields:          strings.Join(fields, ","),
+				},
+			})
+		}
+	}
+
+	c.copyText(shapeID, nil, shape.Text)
+	return nil
+}
+
+func (c *copier) copyImage(element *slides.PageElement, absolute geometry.Matrix) {
+	url := element.Image.SourceUrl
+	if url == "" {
+		url = element.Image.ContentUrl
+	}
+
+	imageID := c.newID(element.ObjectId)
+	c.add(&slides.Request{
+		CreateImage: &slides.CreateImageRequest{
+			ObjectId:          imageID,
+			Url:               url,
+			ElementProperties: c.properties(element, absolute),
+		},
+	})
+
+	if ip := element.Image.ImageProperties; ip != nil {
+		properties := &slides.ImageProperties{}
+		var fields []string
+		if ip.Outline != nil {
+			properties.Outline = ip.Outline
+			fields = append(fields, "outline")
+		}
+		if ip.Link != nil {
+			properties.Link = ip.Link
+			fields = append(fields, "link")
+		}
+		if len(fields) > 0 {
+			c.add(&slides.Request{
+				UpdateImageProperties: &slides.UpdateImagePropertiesRequest{
+					ObjectId:        imageID,
+					ImageProperties: properties,
+					Fields:          strings.Join(fields, ","),
+				},
+			})
+		}
+	}
+}
+
+func (c *copier) copyLine(element *slides.PageElement, absolute geometry.Matrix) {
+	lineID := c.newID(element.ObjectId)
+	c.add(&slides.Request{
+		CreateLine: &slides.CreateLineRequest{
+			ObjectId:          lineID,
+			Category:          element.Line.LineCategory,
+			ElementProperties: c.properties(element, absolute),
+		},
+	})
+
+	lp := element.Line.LineProperties
+	if lp == nil {
+		return
+	}
+
+	properties := &slides.LineProperties{}
+	var fields []string
+	if lp.DashStyle != "" {
+		properties.DashStyle = lp.DashStyle
+		fields = append(fields, "dashStyle")
+	}
+	if lp.StartArrow != "" {
+		properties.StartArrow = lp.StartArrow
+		fields = append(fields, "startArrow")
+	}
+	if lp.EndArrow != "" {
+		properties.EndArrow = lp.EndArrow
+		fields = append(fields, "endArrow")
+	}
+	if lp.Weight != nil {
+		properties.Weight = lp.Weight
+		fields = append(fields, "weight")
+	}
+	if lp.LineFill != nil {
+		properties.LineFill = lp.LineFill
+		fields = append(fields, "lineFill")
+	}
+	if lp.Link != nil {
+		properties.Link = lp.Link
+		fields = append(fields, "link")
+	}
+
+	if len(fields) > 0 {
+		c.add(&slides.Request{
+			UpdateLineProperties: &slides.UpdateLinePropertiesRequest{
+				ObjectId:       lineID,
+				LineProperties: properties,
+				Fields:         strings.Join(fields, ","),
+			},
+		})
+	}
+}
+
+func (c *copier) copyTable(element *slides.PageElement, absolute geometry.Matrix) {
+	table := element.Table
+	tableID := c.newID(element.ObjectId)
+	c.add(&slides.Request{
+		CreateTable: &slides.CreateTableRequest{
+			ObjectId:          tableID,
+			Rows:              table.Rows,
+			Columns:           table.Columns,
+			ElementProperties: c.properties(element, absolute),
+		},
+	})
+
+	for colIdx, column := range table.TableColumns {
+		if column.ColumnWidth == nil {
+			continue
+		}
+		c.add(&slides.Request{
+			UpdateTableColumnProperties: &slides.UpdateTableColumnPropertiesRequest{
+				ObjectId:              tableID,
+				ColumnIndices:         []int64{int64(colIdx)},
+				TableColumnProperties: &slides.TableColumnProperties{ColumnWidth: column.ColumnWidth},
+				Fields:                "columnWidth",
+			},
+		})
+	}
+
+	for rowIdx, row := range table.TableRows {
+		for colIdx, cell := range row.TableCells {
+			location := &slides.TableCellLocation{
+				RowIndex:        int64(rowIdx),
+				ColumnIndex:     int64(colIdx),
+				ForceSendFields: []string{"RowIndex", "ColumnIndex"},
+			}
+			if cell.RowSpan > 1 || cell.ColumnSpan > 1 {
+				c.add(&slides.Request{
+					MergeTableCells: &slides.MergeTableCellsRequest{
+						ObjectId: tableID,
+						TableRange: &slides.TableRange{
+							Location:   location,
+							RowSpan:    max(cell.RowSpan, 1),
+							ColumnSpan: max(cell.ColumnSpan, 1),
+						},
+					},
+				})
+			}
+			c.copyText(tableID, location, cell.Text)
+		}
+	}
+}
+
+// copyText inserts the text of a shape or table cell and reapplies its run
+// text styles, paragraph styles and bullets.
+func (c *copier) copyText(objectID string, cell *slides.TableCellLocation, content *slides.TextContent) {
+	if content == nil {
+		return
+	}
+
+	// Every text ends with a newline that the API adds by itself.
+	plain := strings.TrimSuffix(text.PlainText(content), "\n")
+	if plain == "" {
+		return
+	}
+
+	c.add(&slides.Request{
+		InsertText: &slides.InsertTextRequest{
+			ObjectId:       objectID,
+			CellLocation:   cell,
+			Text:           plain,
+			InsertionIndex: 0,
+		},
+	})
+
+	length := text.UTF16Len(plain)
+	for _, te := range content.TextElements {
+		start, end := te.StartIndex, min(te.EndIndex, length)
+		if start >= end {
+			continue
+		}
+		textRange := &slides.Range{Type: "FIXED_RANGE", StartIndex: &start, EndIndex: &end}
+
+		switch {
+		case te.TextRun != nil && te.TextRun.Style != nil:
+			c.add(&slides.Request{
+				UpdateTextStyle: &slides.UpdateTextStyleRequest{
+					ObjectId:     objectID,
+					CellLocation: cell,
+					TextRange:    textRange,
+					Style:        te.TextRun.Style,
+					Fields:       "*",
+				},
+			})
+		case te.ParagraphMarker != nil:
+			if te.ParagraphMarker.Style != nil {
+				c.add(&slides.Request{
+					UpdateParagraphStyle: &slides.UpdateParagraphStyleRequest{
+						ObjectId:     objectID,
+						CellLocation: cell,
+						TextRange:    textRange,
+						Style:        te.ParagraphMarker.Style,
+						Fields:       "*",
+					},
+				})
+			}
+			if te.ParagraphMarker.Bullet != nil {
+				c.add(&slides.Request{
+					CreateParagraphBullets: &slides.CreateParagraphBulletsRequest{
+						ObjectId:     objectID,
+						CellLocation: cell,
+						TextRange:    textRange,
+					},
+				})
+			}
+		}
+	}
+}
+
+// copySize copies a size, forcing zero magnitudes to be sent (lines can have
+// a zero width or height).
+func copySize(size *slides.Size) *slides.Size {
+	if size == nil {
+		return nil
+	}
+
+	width, height := geometry.SizePT(size)
+	return &slides.Size{
+		Width:  &slides.Dimension{Magnitude: width, Unit: "PT", ForceSendFields: []string{"Magnitude"}},
+		Height: &slides.Dimension{Magnitude: height, Unit: "PT", ForceSendFields: []string{"Magnitude"}},
+	}
+}
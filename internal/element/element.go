@@ -0,0 +1,280 @@
+package element
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"slices"
+	"strings"
+	"time"
+
+	"google.golang.org/api/slides/v1"
+
+	"google-slide-manager/internal/geometry"
+)
+
+// ZOrderOperations lists the z-order operations of UpdatePageElementsZOrder.
+var ZOrderOperations = []string{"BRING_TO_FRONT", "BRING_FORWARD", "SEND_BACKWARD", "SEND_TO_BACK"}
+
+// Service wraps Google Slides service for page element operations.
+type Service struct {
+	slidesService *slides.Service
+}
+
+// NewService creates a new element service.
+func NewService(ctx context.Context, slidesService *slides.Service) *Service {
+	return &Service{
+		slidesService: slidesService,
+	}
+}
+
+// generateObjectID generates a unique object ID using timestamp.
+func generateObjectID(prefix string) string {
+	return fmt.Sprintf("%s_%d", prefix, time.Now().UnixNano())
+}
+
+// Move moves an element. X and Y set the absolute top-left corner of its
+// bounding box in PT; when nil, DX and DY shift it relatively instead.
+func (s *Service) Move(ctx context.Context, presentationID string, objectID string, x *float64, y *float64, dx float64, dy float64) error {
+	placement, err := s.locate(presentationID, objectID)
+	if err != nil {
+		return err
+	}
+
+	bounds := placement.Bounds()
+	if x != nil {
+		dx = *x - bounds.X
+	}
+	if y != nil {
+		dy = *y - bounds.Y
+	}
+
+	return s.applyTransform(presentationID, placement, geometry.Translation(dx, dy), "error moving element")
+}
+
+// Resize resizes an element, keeping its top-left corner in place. Width and
+// height set the rendered size in PT along the element's own axes, so rotated
+// elements keep their rotation; when both are nil, scale is applied instead.
+func (s *Service) Resize(ctx context.Context, presentationID string, objectID string, width *float64, height *float64, scale float64) error {
+	placement, err := s.locate(presentationID, objectID)
+	if err != nil {
+		return err
+	}
+
+	fx, fy := scale, scale
+	if width != nil || height != nil {
+		fx, fy = 1, 1
+	}
+	if fx <= 0 || fy <= 0 {
+		return fmt.Errorf("scale must be positive")
+	}
+
+	element := placement.Element
+
+	// Groups have no size of their own: scale their bounding box on the page.
+	if element.Size == nil {
+		bounds := placement.Bounds()
+		if width != nil {
+			fx = *width / bounds.Width
+		}
+		if height != nil {
+			fy = *height / bounds.Height
+		}
+		if err := checkFactors(fx, fy); err != nil {
+			return err
+		}
+		op := geometry.Translation(bounds.X, bounds.Y).
+			Multiply(geometry.Scaling(fx, fy)).
+			Multiply(geometry.Translation(-bounds.X, -bounds.Y))
+		return s.applyTransform(presentationID, placement, op, "error resizing element")
+	}
+
+	sizeWidth, sizeHeight := geometry.SizePT(element.Size)
+	abs := placement.Absolute
+	if width != nil {
+		fx = *width / (sizeWidth * math.Hypot(abs.ScaleX, abs.ShearY))
+	}
+	if height != nil {
+		fy = *height / (sizeHeight * math.Hypot(abs.ShearX, abs.ScaleY))
+	}
+	if err := checkFactors(fx, fy); err != nil {
+		return err
+	}
+
+	own := placement.Parent.Inverse().Multiply(abs)
+	return s.update(presentationID, objectID, own.Multiply(geometry.Scaling(fx, fy)), "error resizing element")
+}
+
+// Rotate rotates an element around the center of its bounding box. Degrees
+// sets the absolute clockwise rotation; when nil, by rotates relatively.
+func (s *Service) Rotate(ctx context.Context, presentationID string, objectID string, degrees *float64, by float64) error {
+	placement, err := s.locate(presentationID, objectID)
+	if err != nil {
+		return err
+	}
+
+	if degrees != nil {
+		by = *degrees - placement.Absolute.Rotation()
+	}
+
+	cx, cy := placement.Bounds().Center()
+	return s.applyTransform(presentationID, placement, geometry.RotationAround(by, cx, cy), "error rotating element")
+}
+
+// ZOrder changes the stacking order of elements on their page.
+func (s *Service) ZOrder(ctx context.Context, presentationID string, objectIDs []string, operation string) error {
+	operation = strings.ToUpper(operation)
+	if !slices.Contains(ZOrderOperations, operation) {
+		return fmt.Errorf("invalid z-order operation %q (expected one of %s)", operation, strings.Join(ZOrderOperations, ", "))
+	}
+
+	requests := []*slides.Request{
+		{
+			UpdatePageElementsZOrder: &slides.UpdatePageElementsZOrderRequest{
+				PageElementObjectIds: objectIDs,
+				Operation:            operation,
+			},
+		},
+	}
+
+	_, err := s.slidesService.Presentations.BatchUpdate(presentationID, &slides.BatchUpdatePresentationRequest{
+		Requests: requests,
+	}).Do()
+
+	if err != nil {
+		return fmt.Errorf("error changing z-order: %w", err)
+	}
+
+	return nil
+}
+
+// Delete deletes page elements.
+func (s *Service) Delete(ctx context.Context, presentationID string, objectIDs []string) error {
+	var requests []*slides.Request
+	for _, objectID := range objectIDs {
+		requests = append(requests, &slides.Request{
+			DeleteObject: &slides.DeleteObjectRequest{
+				ObjectId: objectID,
+			},
+		})
+	}
+
+	_, err := s.slidesService.Presentations.BatchUpdate(presentationID, &slides.BatchUpdatePresentationRequest{
+		Requests: requests,
+	}).Do()
+
+	if err != nil {
+		return fmt.Errorf("error deleting elements: %w", err)
+	}
+
+	return nil
+}
+
+// Duplicate duplicates an element on its own page, offset by (dx, dy) PT, and
+// returns the mapping from original to new object IDs, including the children
+// of groups.
+func (s *Service) Duplicate(ctx context.Context, presentationID string, objectID string, dx float64, dy float64) (map[string]string, error) {
+	placement, err := s.locate(presentationID, objectID)
+	if err != nil {
+		return nil, err
+	}
+
+	mapping := map[string]string{}
+	base := generateObjectID("copy")
+	mapIDs(placement.Element, base, mapping)
+
+	requests := []*slides.Request{
+		{
+			DuplicateObject: &slides.DuplicateObjectRequest{
+				ObjectId:  objectID,
+				ObjectIds: mapping,
+			},
+		},
+	}
+
+	if dx != 0 || dy != 0 {
+		move := transformRequest(placement, geometry.Translation(dx, dy))
+		move.UpdatePageElementTransform.ObjectId = mapping[objectID]
+		requests = append(requests, move)
+	}
+
+	_, err = s.slidesService.Presentations.BatchUpdate(presentationID, &slides.BatchUpdatePresentationRequest{
+		Requests: requests,
+	}).Do()
+
+	if err != nil {
+		return nil, fmt.Errorf("error duplicating element: %w", err)
+	}
+
+	return mapping, nil
+}
+
+// locate fetches the presentation and finds a slide element by object ID.
+func (s *Service) locate(presentationID string, objectID string) (geometry.Placement, error) {
+	presentation, err := s.slidesService.Presentations.Get(presentationID).Do()
+	if err != nil {
+		return geometry.Placement{}, fmt.Errorf("error getting presentation: %w", err)
+	}
+
+	placement, ok := geometry.Locate(presentation, objectID)
+	if !ok {
+		return geometry.Placement{}, fmt.Errorf("element %s not found", objectID)
+	}
+
+	return placement, nil
+}
+
+// applyTransform applies a page-space transform to an element, expressing the
+// result relative to the element's parent groups.
+func (s *Service) applyTransform(presentationID string, placement geometry.Placement, op geometry.Matrix, errPrefix string) error {
+	_, err := s.slidesService.Presentations.BatchUpdate(presentationID, &slides.BatchUpdatePresentationRequest{
+		Requests: []*slides.Request{transformRequest(placement, op)},
+	}).Do()
+
+	if err != nil {
+		return fmt.Errorf("%s: %w", errPrefix, err)
+	}
+
+	return nil
+}
+
+// update replaces the transform of an element.
+func (s *Service) update(presentationID string, objectID string, own geometry.Matrix, errPrefix string) error {
+	requests := []*slides.Request{
+		{
+			UpdatePageElementTransform: &slides.UpdatePageElementTransformRequest{
+				ObjectId:  objectID,
+				ApplyMode: "ABSOLUTE",
+				Transform: own.Transform(),
+			},
+		},
+	}
+
+	_, err := s.slidesService.Presentations.BatchUpdate(presentationID, &slides.BatchUpdatePresentationRequest{
+		Requests: requests,
+	}).Do()
+
+	if err != nil {
+		return fmt.Errorf("%s: %w", errPrefix, err)
+	}
+
+	return nil
+}
+
+// mapIDs assigns new object IDs to an element and all its group children.
+func mapIDs(element *slides.PageElement, base string, mapping map[string]string) {
+	mapping[element.ObjectId] = fmt.Sprintf("%s_%d", base, len(mapping))
+	if element.ElementGroup != nil {
+		for _, child := range element.ElementGroup.Children {
+			mapIDs(child, base, mapping)
+		}
+	}
+}
+
+// checkFactors validates resize factors.
+func checkFactors(fx, fy float64) error {
+	if fx <= 0 || fy <= 0 || math.IsInf(fx, 0) || math.IsInf(fy, 0) || math.IsNaN(fx) || math.IsNaN(fy) {
+		return fmt.Errorf("cannot resize: element has no extent along the requested axis or target size is not positive")
+	}
+	return nil
+}
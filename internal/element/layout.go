@@ -0,0 +1,252 @@
+package element
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"google.golang.org/api/slides/v1"
+
+	"google-slide-manager/internal/geometry"
+)
+
+// AlignEdges lists the edges and centers elements can be aligned on.
+var AlignEdges = []string{"LEFT", "CENTER", "RIGHT", "TOP", "MIDDLE", "BOTTOM"}
+
+// Alignment references.
+const (
+	RelativeToSlide     = "SLIDE"
+	RelativeToSelection = "SELECTION"
+)
+
+// Distribution directions.
+const (
+	Horizontal = "HORIZONTAL"
+	Vertical   = "VERTICAL"
+)
+
+// Group groups elements of the same page and returns the new group ID.
+func (s *Service) Group(ctx context.Context, presentationID string, objectIDs []string) (string, error) {
+	if len(objectIDs) < 2 {
+		return "", fmt.Errorf("at least two elements are required to create a group")
+	}
+
+	groupID := generateObjectID("group")
+
+	requests := []*slides.Request{
+		{
+			GroupObjects: &slides.GroupObjectsRequest{
+				GroupObjectId:     groupID,
+				ChildrenObjectIds: objectIDs,
+			},
+		},
+	}
+
+	_, err := s.slidesService.Presentations.BatchUpdate(presentationID, &slides.BatchUpdatePresentationRequest{
+		Requests: requests,
+	}).Do()
+
+	if err != nil {
+		return "", fmt.Errorf("error grouping elements: %w", err)
+	}
+
+	return groupID, nil
+}
+
+// Ungroup ungroups groups, keeping their children on the page.
+func (s *Service) Ungroup(ctx context.Context, presentationID string, groupIDs []string) error {
+	requests := []*slides.Request{
+		{
+			UngroupObjects: &slides.UngroupObjectsRequest{
+				ObjectIds: groupIDs,
+			},
+		},
+	}
+
+	_, err := s.slidesService.Presentations.BatchUpdate(presentationID, &slides.BatchUpdatePresentationRequest{
+		Requests: requests,
+	}).Do()
+
+	if err != nil {
+		return fmt.Errorf("error ungrouping elements: %w", err)
+	}
+
+	return nil
+}
+
+// Align aligns the bounding boxes of elements on an edge or center, either of
+// the slide or of the selection (the union of the elements' bounding boxes).
+func (s *Service) Align(ctx context.Context, presentationID string, objectIDs []string, edge string, relativeTo string) error {
+	edge = strings.ToUpper(edge)
+	if !slices.Contains(AlignEdges, edge) {
+		return fmt.Errorf("invalid alignment %q (expected one of %s)", edge, strings.Join(AlignEdges, ", "))
+	}
+
+	relativeTo = strings.ToUpper(relativeTo)
+	if relativeTo != RelativeToSlide && relativeTo != RelativeToSelection {
+		return fmt.Errorf("invalid alignment reference %q (expected SLIDE or SELECTION)", relativeTo)
+	}
+
+	presentation, placements, err := s.locateAll(presentationID, objectIDs)
+	if err != nil {
+		return err
+	}
+
+	var boxes []geometry.Box
+	for _, placement := range placements {
+		boxes = append(boxes, placement.Bounds())
+	}
+
+	var reference geometry.Box
+	if relativeTo == RelativeToSlide {
+		width, height := geometry.SizePT(presentation.PageSize)
+		reference = geometry.Box{Width: width, Height: height}
+	} else {
+		if len(placements) < 2 {
+			return fmt.Errorf("at least two elements are required to align relative to the selection")
+		}
+		reference = geometry.Union(boxes...)
+	}
+
+	var requests []*slides.Request
+	for i, placement := range placements {
+		var dx, dy float64
+		box := boxes[i]
+		switch edge {
+		case "LEFT":
+			dx = reference.X - box.X
+		case "CENTER":
+			dx = reference.X + (reference.Width-box.Width)/2 - box.X
+		case "RIGHT":
+			dx = reference.X + reference.Width - box.Width - box.X
+		case "TOP":
+			dy = reference.Y - box.Y
+		case "MIDDLE":
+			dy = reference.Y + (reference.Height-box.Height)/2 - box.Y
+		case "BOTTOM":
+			dy = reference.Y + reference.Height - box.Height - box.Y
+		}
+		requests = append(requests, transformRequest(placement, geometry.Translation(dx, dy)))
+	}
+
+	_, err = s.slidesService.Presentations.BatchUpdate(presentationID, &slides.BatchUpdatePresentationRequest{
+		Requests: requests,
+	}).Do()
+
+	if err != nil {
+		return fmt.Errorf("error aligning elements: %w", err)
+	}
+
+	return nil
+}
+
+// Distribute spaces elements evenly along a direction. The outermost elements
+// stay in place and the others are moved so that the gaps between consecutive
+// bounding boxes are equal.
+func (s *Service) Distribute(ctx context.Context, presentationID string, objectIDs []string, direction string) error {
+	direction = strings.ToUpper(direction)
+	if direction != Horizontal && direction != Vertical {
+		return fmt.Errorf("invalid direction %q (expected HORIZONTAL or VERTICAL)", direction)
+	}
+
+	if len(objectIDs) < 3 {
+		return fmt.Errorf("at least three elements are required to distribute")
+	}
+
+	_, placements, err := s.locateAll(presentationID, objectIDs)
+	if err != nil {
+		return err
+	}
+
+	// Work on (position, length) pairs along the distribution axis.
+	type item struct {
+		placement geometry.Placement
+		pos, size float64
+	}
+
+	items := make([]item, len(placements))
+	for i, placement := range placements {
+		box := placement.Bounds()
+		if direction == Horizontal {
+			items[i] = item{placement, box.X, box.Width}
+		} else {
+			items[i] = item{placement, box.Y, box.Height}
+		}
+	}
+
+	slices.SortStableFunc(items, func(a, b item) int {
+		switch {
+		case a.pos < b.pos:
+			return -1
+		case a.pos > b.pos:
+			return 1
+		}
+		return 0
+	})
+
+	first, last := items[0], items[len(items)-1]
+	var total float64
+	for _, it := range items {
+		total += it.size
+	}
+	gap := (last.pos + last.size - first.pos - total) / float64(len(items)-1)
+
+	var requests []*slides.Request
+	pos := first.pos + first.size + gap
+	for _, it := range items[1 : len(items)-1] {
+		delta := pos - it.pos
+		if direction == Horizontal {
+			requests = append(requests, transformRequest(it.placement, geometry.Translation(delta, 0)))
+		} else {
+			requests = append(requests, transformRequest(it.placement, geometry.Translation(0, delta)))
+		}
+		pos += it.size + gap
+	}
+
+	_, err = s.slidesService.Presentations.BatchUpdate(presentationID, &slides.BatchUpdatePresentationRequest{
+		Requests: requests,
+	}).Do()
+
+	if err != nil {
+		return fmt.Errorf("error distributing elements: %w", err)
+	}
+
+	return nil
+}
+
+// locateAll fetches the presentation and finds elements that must all be on
+// the same slide.
+func (s *Service) locateAll(presentationID string, objectIDs []string) (*slides.Presentation, []geometry.Placement, error) {
+	presentation, err := s.slidesService.Presentations.Get(presentationID).Do()
+	if err != nil {
+		return nil, nil, fmt.Errorf("error getting presentation: %w", err)
+	}
+
+	var placements []geometry.Placement
+	for _, objectID := range objectIDs {
+		placement, ok := geometry.Locate(presentation, objectID)
+		if !ok {
+			return nil, nil, fmt.Errorf("element %s not found", objectID)
+		}
+		if len(placements) > 0 && placement.PageObjectID != placements[0].PageObjectID {
+			return nil, nil, fmt.Errorf("elements %s and %s are not on the same slide", placements[0].Element.ObjectId, objectID)
+		}
+		placements = append(placements, placement)
+	}
+
+	return presentation, placements, nil
+}
+
+// transformRequest builds the request applying a page-space transform to an
+// element, expressed relative to its parent groups.
+func transformRequest(placement geometry.Placement, op geometry.Matrix) *slides.Request {
+	own := placement.Parent.Inverse().Multiply(op).Multiply(placement.Absolute)
+	return &slides.Request{
+		UpdatePageElementTransform: &slides.UpdatePageElementTransformRequest{
+			ObjectId:  placement.Element.ObjectId,
+			ApplyMode: "ABSOLUTE",
+			Transform: own.Transform(),
+		},
+	}
+}
@@ -3,63 +3,152 @@ package export
 import (
 	"context"
 	"fmt"
+	"io"
 	"os"
+	"path/filepath"
+	"sort"
+	"strings"
 
 	"google.golang.org/api/drive/v3"
+	"google.golang.org/api/slides/v1"
 )
 
-// Service wraps Google Drive service for export operations.
+// Formats maps the export formats supported by Drive for presentations to
+// their MIME types. Image formats only render the first slide.
+var Formats = map[string]string{
+	"pdf":  "application/pdf",
+	"pptx": "application/vnd.openxmlformats-officedocument.presentationml.presentation",
+	"odp":  "application/vnd.oasis.opendocument.presentation",
+	"txt":  "text/plain",
+	"jpeg": "image/jpeg",
+	"png":  "image/png",
+	"svg":  "image/svg+xml",
+}
+
+// Stdout is the output name that streams an export to standard output.
+const Stdout = "-"
+
+// Service wraps Google Slides and Drive services for export operations.
 type Service struct {
-	driveService *drive.Service
+	slidesService *slides.Service
+	driveService  *drive.Service
 }
 
 // NewService creates a new export service.
-func NewService(ctx context.Context, driveService *drive.Service) *Service {
+func NewService(ctx context.Context, slidesService *slides.Service, driveService *drive.Service) *Service {
 	return &Service{
-		driveService: driveService,
+		slidesService: slidesService,
+		driveService:  driveService,
 	}
 }
 
-// ToPDF exports a presentation as PDF.
-func (s *Service) ToPDF(ctx context.Context, presentationID string, outputFile string) error {
-	resp, err := s.driveService.Files.Export(presentationID, "application/pdf").Download()
-	if err != nil {
-		return fmt.Errorf("error exporting as PDF: %w", err)
+// Result describes a completed export.
+type Result struct {
+	Format   string `json:"format"`
+	MimeType string `json:"mime_type"`
+	Output   string `json:"output"`
+	Bytes    int64  `json:"bytes"`
+}
+
+// FormatNames returns the supported export formats in alphabetical order.
+func FormatNames() []string {
+	names := make([]string, 0, len(Formats))
+	for name := range Formats {
+		names = append(names, name)
 	}
-	defer resp.Body.Close()
+	sort.Strings(names)
+	return names
+}
 
-	f, err := os.Create(outputFile)
-	if err != nil {
-		return fmt.Errorf("error creating output file: %w", err)
+// FormatFromPath infers the export format from the extension of a file name.
+// It returns false when the extension is not a supported format.
+func FormatFromPath(path string) (string, bool) {
+	ext := strings.ToLower(strings.TrimPrefix(filepath.Ext(path), "."))
+	if ext == "jpg" {
+		ext = "jpeg"
 	}
-	defer f.Close()
+	_, ok := Formats[ext]
+	return ext, ok
+}
 
-	_, err = f.ReadFrom(resp.Body)
-	if err != nil {
-		return fmt.Errorf("error writing PDF: %w", err)
+// Export downloads a presentation in format and writes it to output, or to
+// standard output when output is Stdout. Files are written atomically, so a
+// failed download never leaves a truncated file behind. When slideIndices is
+// not nil, only the slides at those 0-based indices are exported, through a
+// temporary copy of the presentation that is deleted afterwards.
+func (s *Service) Export(ctx context.Context, presentationID string, format string, output string, slideIndices []int64) (*Result, error) {
+	format = strings.ToLower(format)
+	if format == "jpg" {
+		format = "jpeg"
+	}
+	mimeType, ok := Formats[format]
+	if !ok {
+		return nil, fmt.Errorf("invalid export format %q (expected one of %s)", format, strings.Join(FormatNames(), ", "))
 	}
 
-	return nil
-}
+	if slideIndices != nil {
+		copyID, cleanup, err := s.copySubset(ctx, presentationID, slideIndices)
+		if err != nil {
+			return nil, err
+		}
+		defer cleanup()
+		presentationID = copyID
+	}
 
-// ToPPTX exports a presentation as PowerPoint.
-func (s *Service) ToPPTX(ctx context.Context, presentationID string, outputFile string) error {
-	resp, err := s.driveService.Files.Export(presentationID, "application/vnd.openxmlformats-officedocument.presentationml.presentation").Download()
+	resp, err := s.driveService.Files.Export(presentationID, mimeType).Context(ctx).Download()
 	if err != nil {
-		return fmt.Errorf("error exporting as PPTX: %w", err)
+		return nil, fmt.Errorf("error exporting as %s: %w", strings.ToUpper(format), err)
 	}
 	defer resp.Body.Close()
 
-	f, err := os.Create(outputFile)
+	result := &Result{Format: format, MimeType: mimeType, Output: output}
+
+	if output == Stdout {
+		result.Bytes, err = io.Copy(os.Stdout, resp.Body)
+		if err != nil {
+			return nil, fmt.Errorf("error writing %s: %w", strings.ToUpper(format), err)
+		}
+		return result, nil
+	}
+
+	err = WriteAtomic(output, func(w io.Writer) error {
+		n, err := io.Copy(w, resp.Body)
+		result.Bytes = n
+		return err
+	})
 	if err != nil {
-		return fmt.Errorf("error creating output file: %w", err)
+		return nil, fmt.Errorf("error writing %s: %w", strings.ToUpper(format), err)
 	}
-	defer f.Close()
 
-	_, err = f.ReadFrom(resp.Body)
+	return result, nil
+}
+
+// WriteAtomic writes a file through a temporary file in the same directory
+// that is renamed over path once write succeeds. On failure the temporary file
+// is removed and any existing file at path is left untouched.
+func WriteAtomic(path string, write func(w io.Writer) error) (err error) {
+	f, err := os.CreateTemp(filepath.Dir(path), "."+filepath.Base(path)+".*.tmp")
 	if err != nil {
-		return fmt.Errorf("error writing PPTX: %w", err)
+		return err
 	}
+	defer func() {
+		if err != nil {
+			f.Close()
+			os.Remove(f.Name())
+		}
+	}()
 
-	return nil
+	if err = f.Chmod(0o644); err != nil {
+		return err
+	}
+	if err = write(f); err != nil {
+		return err
+	}
+	if err = f.Sync(); err != nil {
+		return err
+	}
+	if err = f.Close(); err != nil {
+		return err
+	}
+	return os.Rename(f.Name(), path)
 }
@@ -0,0 +1,185 @@
+package export
+
+import (
+	"fmt"
+	"html"
+	"io"
+	"path"
+	"strings"
+
+	"google-slide-manager/internal/notes"
+)
+
+// DefaultWPM is the default speaking rate used to estimate timings.
+const DefaultWPM = 130
+
+// Script formats.
+const (
+	ScriptMarkdown = "md"
+	ScriptHTML     = "html"
+	ScriptText     = "txt"
+)
+
+// ScriptSlide is a slide of a speaker script with its estimated timing.
+type ScriptSlide struct {
+	Number    int
+	Title     string
+	Notes     string
+	Thumbnail string
+	Words     int
+	// Seconds and Cumulative are the estimated speaking time of the slide and
+	// of the script up to and including the slide.
+	Seconds    int
+	Cumulative int
+}
+
+// Script is a speaker script: every slide with its notes and timing.
+type Script struct {
+	Slides       []ScriptSlide
+	WPM          int
+	TotalWords   int
+	TotalSeconds int
+}
+
+// ThumbnailName returns the file name of the thumbnail of a slide, numbered
+// from 1 like the slides of a script.
+func ThumbnailName(slideIndex int, ext string) string {
+	return fmt.Sprintf("slide_%03d.%s", slideIndex+1, ext)
+}
+
+// BuildScript builds a speaker script from the notes of every slide, timing
+// each slide from its word count at wpm words per minute. Thumbnails are
+// referenced as PNG files in thumbnailDir.
+func BuildScript(allNotes []notes.SlideNotes, wpm int, thumbnailDir string) (*Script, error) {
+	if wpm <= 0 {
+		return nil, fmt.Errorf("words per minute must be positive")
+	}
+
+	script := &Script{WPM: wpm}
+	for i, entry := range allNotes {
+		idx := i
+		if entry.SlideIndex != nil {
+			idx = *entry.SlideIndex
+		}
+
+		words := len(strings.Fields(entry.Notes))
+		seconds := (words*60 + wpm/2) / wpm
+		script.TotalWords += words
+		script.TotalSeconds += seconds
+
+		script.Slides = append(script.Slides, ScriptSlide{
+			Number:     idx + 1,
+			Title:      entry.Title,
+			Notes:      entry.Notes,
+			Thumbnail:  path.Join(thumbnailDir, ThumbnailName(idx, "png")),
+			Words:      words,
+			Seconds:    seconds,
+			Cumulative: script.TotalSeconds,
+		})
+	}
+
+	return script, nil
+}
+
+// WriteScript writes a script as Markdown, HTML (a teleprompter page) or text.
+func WriteScript(w io.Writer, script *Script, format string) error {
+	switch format {
+	case ScriptMarkdown:
+		return writeScriptMarkdown(w, script)
+	case ScriptHTML:
+		return writeScriptHTML(w, script)
+	case ScriptText:
+		return writeScriptText(w, script)
+	default:
+		return fmt.Errorf("invalid script format %q (expected md, html or txt)", format)
+	}
+}
+
+func writeScriptMarkdown(w io.Writer, script *Script) error {
+	var sb strings.Builder
+	sb.WriteString("# Speaker Script\n")
+
+	for _, slide := range script.Slides {
+		fmt.Fprintf(&sb, "\n## %d. %s\n\n", slide.Number, slideHeading(slide))
+		fmt.Fprintf(&sb, "*%s (%d words) · %s elapsed*\n\n", duration(slide.Seconds), slide.Words, duration(slide.Cumulative))
+		fmt.Fprintf(&sb, "![Slide %d](%s)\n\n", slide.Number, slide.Thumbnail)
+		if slide.Notes != "" {
+			sb.WriteString(slide.Notes + "\n")
+		} else {
+			sb.WriteString("*No notes.*\n")
+		}
+	}
+
+	fmt.Fprintf(&sb, "\n---\n\n**Total: %s** (%d words at %d WPM)\n", duration(script.TotalSeconds), script.TotalWords, script.WPM)
+
+	_, err := io.WriteString(w, sb.String())
+	return err
+}
+
+func writeScriptText(w io.Writer, script *Script) error {
+	var sb strings.Builder
+	for _, slide := range script.Slides {
+		fmt.Fprintf(&sb, "SLIDE %d: %s\n", slide.Number, slideHeading(slide))
+		fmt.Fprintf(&sb, "[%s, %d words, %s elapsed | thumbnail: %s]\n\n", duration(slide.Seconds), slide.Words, duration(slide.Cumulative), slide.Thumbnail)
+		if slide.Notes != "" {
+			sb.WriteString(slide.Notes + "\n")
+		}
+		sb.WriteString("\n")
+	}
+
+	fmt.Fprintf(&sb, "TOTAL: %s (%d words at %d WPM)\n", duration(script.TotalSeconds), script.TotalWords, script.WPM)
+
+	_, err := io.WriteString(w, sb.String())
+	return err
+}
+
+func writeScriptHTML(w io.Writer, script *Script) error {
+	var sb strings.Builder
+	sb.WriteString(`<!DOCTYPE html>
+<html>
+<head>
+<meta charset="utf-8">
+<title>Speaker Script</title>
+<style>
+body { background: #111; color: #eee; font-family: sans-serif; font-size: 2em; line-height: 1.5; max-width: 40em; margin: 0 auto; padding: 1em; }
+section { border-bottom: 1px solid #444; padding: 1em 0; }
+h2 { color: #8ab4f8; margin-bottom: 0.2em; }
+.timing { color: #999; font-size: 0.6em; }
+img { max-width: 100%; height: auto; margin: 0.5em 0; }
+footer { font-size: 0.8em; padding: 1em 0; }
+</style>
+</head>
+<body>
+`)
+
+	for _, slide := range script.Slides {
+		sb.WriteString("<section>\n")
+		fmt.Fprintf(&sb, "<h2>%d. %s</h2>\n", slide.Number, html.EscapeString(slideHeading(slide)))
+		fmt.Fprintf(&sb, "<div class=\"timing\">%s · %d words · %s elapsed</div>\n", duration(slide.Seconds), slide.Words, duration(slide.Cumulative))
+		fmt.Fprintf(&sb, "<img src=\"%s\" alt=\"Slide %d\">\n", html.EscapeString(slide.Thumbnail), slide.Number)
+		for _, paragraph := range strings.Split(slide.Notes, "\n") {
+			if strings.TrimSpace(paragraph) != "" {
+				fmt.Fprintf(&sb, "<p>%s</p>\n", html.EscapeString(paragraph))
+			}
+		}
+		sb.WriteString("</section>\n")
+	}
+
+	fmt.Fprintf(&sb, "<footer>Total: %s (%d words at %d WPM)</footer>\n</body>\n</html>\n", duration(script.TotalSeconds), script.TotalWords, script.WPM)
+
+	_, err := io.WriteString(w, sb.String())
+	return err
+}
+
+// slideHeading returns the title of a slide, or a placeholder when it has none.
+func slideHeading(slide ScriptSlide) string {
+	if slide.Title == "" {
+		return "(untitled)"
+	}
+	return slide.Title
+}
+
+// duration formats seconds as m:ss.
+func duration(seconds int) string {
+	return fmt.Sprintf("%d:%02d", seconds/60, seconds%60)
+}
@@ -0,0 +1,84 @@
+package export
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"time"
+
+	"google.golang.org/api/drive/v3"
+	"google.golang.org/api/slides/v1"
+)
+
+// cleanupTimeout bounds the deletion of a temporary copy once the export is
+// over, including after the export context has been cancelled.
+const cleanupTimeout = 30 * time.Second
+
+// copySubset copies a presentation through Drive and deletes every slide not
+// listed in slideIndices from the copy in a single batch. It returns the ID of
+// the copy and a cleanup function deleting it, which must always be called,
+// even when ctx is cancelled. On error the copy is already deleted.
+func (s *Service) copySubset(ctx context.Context, presentationID string, slideIndices []int64) (string, func(), error) {
+	noop := func() {}
+
+	presentation, err := s.slidesService.Presentations.Get(presentationID).Context(ctx).Do()
+	if err != nil {
+		return "", noop, fmt.Errorf("error getting presentation: %w", err)
+	}
+
+	keep := map[int64]bool{}
+	for _, idx := range slideIndices {
+		if idx < 0 || idx >= int64(len(presentation.Slides)) {
+			return "", noop, fmt.Errorf("slide %d out of range (presentation has %d slides)", idx+1, len(presentation.Slides))
+		}
+		keep[idx] = true
+	}
+	if len(keep) == 0 {
+		return "", noop, fmt.Errorf("no slides selected")
+	}
+
+	copied, err := s.driveService.Files.Copy(presentationID, &drive.File{
+		Name: fmt.Sprintf("%s (temporary export copy)", presentation.Title),
+	}).Fields("id").SupportsAllDrives(true).Context(ctx).Do()
+	if err != nil {
+		return "", noop, fmt.Errorf("error copying presentation: %w", err)
+	}
+
+	cleanup := func() {
+		cleanupCtx, cancel := context.WithTimeout(context.WithoutCancel(ctx), cleanupTimeout)
+		defer cancel()
+
+		if err := s.driveService.Files.Delete(copied.Id).SupportsAllDrives(true).Context(cleanupCtx).Do(); err != nil {
+			fmt.Fprintf(os.Stderr, "⚠️  Could not delete temporary copy %s: %v\n", copied.Id, err)
+		}
+	}
+
+	// Read the slides back from the copy: Drive does not promise to keep
+	// object IDs when copying.
+	copiedPresentation, err := s.slidesService.Presentations.Get(copied.Id).Context(ctx).Do()
+	if err != nil {
+		cleanup()
+		return "", noop, fmt.Errorf("error getting presentation copy: %w", err)
+	}
+
+	var requests []*slides.Request
+	for i, slide := range copiedPresentation.Slides {
+		if !keep[int64(i)] {
+			requests = append(requests, &slides.Request{
+				DeleteObject: &slides.DeleteObjectRequest{ObjectId: slide.ObjectId},
+			})
+		}
+	}
+
+	if len(requests) > 0 {
+		_, err = s.slidesService.Presentations.BatchUpdate(copied.Id, &slides.BatchUpdatePresentationRequest{
+			Requests: requests,
+		}).Context(ctx).Do()
+		if err != nil {
+			cleanup()
+			return "", noop, fmt.Errorf("error deleting unselected slides: %w", err)
+		}
+	}
+
+	return copied.Id, cleanup, nil
+}
@@ -0,0 +1,319 @@
+package export
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"image/jpeg"
+	"image/png"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"google.golang.org/api/googleapi"
+	"google.golang.org/api/slides/v1"
+
+	"google-slide-manager/internal/text"
+)
+
+// ThumbnailSizes lists the thumbnail sizes of the Slides API.
+var ThumbnailSizes = []string{"SMALL", "MEDIUM", "LARGE"}
+
+// DefaultThumbnailTemplate names thumbnails like the references of export-script.
+const DefaultThumbnailTemplate = "slide_{number:03}"
+
+// maxRetries is the number of retries of a thumbnail request rejected for
+// exceeding the quota.
+const maxRetries = 4
+
+// httpClient downloads rendered thumbnails; the timeout keeps a stalled
+// download from holding up the export.
+var httpClient = &http.Client{Timeout: 30 * time.Second}
+
+var placeholderPattern = regexp.MustCompile(`\{([a-z-]+)(?::(\d+))?\}`)
+
+// ThumbnailOptions configures ExportThumbnails.
+type ThumbnailOptions struct {
+	// SlideIndices selects the slides to export by 0-based index; nil exports
+	// every slide.
+	SlideIndices []int64
+	Size         string
+	// Format is png or jpeg.
+	Format string
+	// Template names the files. Placeholders: {index} or {number} (the
+	// 1-based slide number), {id}, {title-slug} and {ext}, with an optional
+	// zero padding width such as {index:03}. The extension is added when
+	// missing.
+	Template string
+	// Concurrency is the maximum number of downloads in flight.
+	Concurrency int
+	// Rate is the maximum number of thumbnail requests per second.
+	Rate float64
+}
+
+// Thumbnail is an exported slide image.
+type Thumbnail struct {
+	// Slide is the 1-based slide number.
+	Slide    int    `json:"slide"`
+	ObjectID string `json:"object_id"`
+	Path     string `json:"path"`
+	Error    string `json:"error,omitempty"`
+}
+
+// ExportThumbnails renders slides as images into dir. Downloads run in
+// parallel, limited by opts.Concurrency and opts.Rate, and requests rejected
+// for exceeding the quota are retried with exponential backoff. A failed slide
+// does not stop the others; its error is reported in the result.
+func (s *Service) ExportThumbnails(ctx context.Context, presentationID string, dir string, opts ThumbnailOptions) ([]Thumbnail, error) {
+	size := strings.ToUpper(opts.Size)
+	if !slices.Contains(ThumbnailSizes, size) {
+		return nil, fmt.Errorf("invalid thumbnail size %q (expected one of %s)", opts.Size, strings.Join(ThumbnailSizes, ", "))
+	}
+
+	format := strings.ToLower(opts.Format)
+	if format == "jpg" {
+		format = "jpeg"
+	}
+	if format != "png" && format != "jpeg" {
+		return nil, fmt.Errorf("invalid image format %q (expected png or jpeg)", opts.Format)
+	}
+
+	presentation, err := s.slidesService.Presentations.Get(presentationID).Do()
+	if err != nil {
+		return nil, fmt.Errorf("error getting presentation: %w", err)
+	}
+
+	indices := opts.SlideIndices
+	if indices == nil {
+		for i := range presentation.Slides {
+			indices = append(indices, int64(i))
+		}
+	}
+
+	template := opts.Template
+	if template == "" {
+		template = DefaultThumbnailTemplate
+	}
+
+	thumbnails := make([]Thumbnail, len(indices))
+	seen := map[string]bool{}
+	for i, idx := range indices {
+		if idx < 0 || idx >= int64(len(presentation.Slides)) {
+			return nil, fmt.Errorf("slide %d out of range (presentation has %d slides)", idx+1, len(presentation.Slides))
+		}
+
+		slide := presentation.Slides[idx]
+		name, err := renderName(template, int(idx)+1, slide, extension(format))
+		if err != nil {
+			return nil, err
+		}
+		if seen[name] {
+			return nil, fmt.Errorf("file name template %q gives the same name %q to several slides", template, name)
+		}
+		seen[name] = true
+
+		thumbnails[i] = Thumbnail{Slide: int(idx) + 1, ObjectID: slide.ObjectId, Path: filepath.Join(dir, name)}
+	}
+
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return nil, fmt.Errorf("error creating output directory: %w", err)
+	}
+
+	concurrency := max(opts.Concurrency, 1)
+	interval := time.Duration(0)
+	if opts.Rate > 0 {
+		interval = time.Duration(float64(time.Second) / opts.Rate)
+	}
+	limiter := newLimiter(interval)
+	defer limiter.stop()
+
+	sem := make(chan struct{}, concurrency)
+	var wg sync.WaitGroup
+
+	for i := range thumbnails {
+		wg.Add(1)
+		go func(t *Thumbnail) {
+			defer wg.Done()
+			sem <- struct{}{}
+			defer func() { <-sem }()
+
+			if err := s.exportThumbnail(ctx, presentationID, t, size, format, limiter); err != nil {
+				t.Error = err.Error()
+			}
+		}(&thumbnails[i])
+	}
+
+	wg.Wait()
+
+	return thumbnails, nil
+}
+
+// exportThumbnail requests, downloads and writes the thumbnail of one slide.
+func (s *Service) exportThumbnail(ctx context.Context, presentationID string, t *Thumbnail, size string, format string, limiter *limiter) error {
+	var thumbnail *slides.Thumbnail
+	var err error
+	for attempt := 0; ; attempt++ {
+		if err := limiter.wait(ctx); err != nil {
+			return err
+		}
+
+		thumbnail, err = s.slidesService.Presentations.Pages.GetThumbnail(presentationID, t.ObjectID).
+			ThumbnailPropertiesThumbnailSize(size).
+			ThumbnailPropertiesMimeType("PNG").
+			Context(ctx).Do()
+
+		var apiErr *googleapi.Error
+		if err == nil || attempt == maxRetries || !errors.As(err, &apiErr) || apiErr.Code != http.StatusTooManyRequests {
+			break
+		}
+
+		select {
+		case <-time.After(time.Duration(1<<attempt) * time.Second):
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+	if err != nil {
+		return fmt.Errorf("error getting thumbnail: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, thumbnail.ContentUrl, nil)
+	if err != nil {
+		return fmt.Errorf("error downloading thumbnail: %w", err)
+	}
+
+	resp, err := httpClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("error downloading thumbnail: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("error downloading thumbnail: %s", resp.Status)
+	}
+
+	err = WriteAtomic(t.Path, func(w io.Writer) error {
+		if format == "jpeg" {
+			img, err := png.Decode(resp.Body)
+			if err != nil {
+				return fmt.Errorf("error decoding thumbnail: %w", err)
+			}
+			return jpeg.Encode(w, img, &jpeg.Options{Quality: 90})
+		}
+
+		_, err := io.Copy(w, resp.Body)
+		return err
+	})
+	if err != nil {
+		return fmt.Errorf("error writing image: %w", err)
+	}
+	return nil
+}
+
+// renderName expands a file name template for a slide with a 1-based number.
+func renderName(template string, number int, slide *slides.Page, ext string) (string, error) {
+	var unknown string
+	name := placeholderPattern.ReplaceAllStringFunc(template, func(match string) string {
+		m := placeholderPattern.FindStringSubmatch(match)
+		width, _ := strconv.Atoi(m[2])
+
+		var value string
+		switch m[1] {
+		case "index", "number":
+			value = fmt.Sprintf("%0*d", width, number)
+		case "id":
+			value = slide.ObjectId
+		case "title-slug":
+			value = slug(text.SlideTitle(slide))
+		case "ext":
+			value = ext
+		default:
+			unknown = match
+		}
+		return value
+	})
+
+	if unknown != "" {
+		return "", fmt.Errorf("unknown placeholder %s in file name template (expected {index}, {number}, {id}, {title-slug} or {ext})", unknown)
+	}
+
+	if strings.ContainsAny(name, `/\`) {
+		return "", fmt.Errorf("file name %q must not contain path separators", name)
+	}
+
+	if filepath.Ext(name) == "" {
+		name += "." + ext
+	}
+
+	return name, nil
+}
+
+// extension returns the file extension of an image format.
+func extension(format string) string {
+	if format == "jpeg" {
+		return "jpg"
+	}
+	return format
+}
+
+// slug converts a title to a lowercase, dash-separated file name fragment.
+func slug(title string) string {
+	var sb strings.Builder
+	dash := false
+	for _, r := range strings.ToLower(title) {
+		if (r >= 'a' && r <= 'z') || (r >= '0' && r <= '9') {
+			sb.WriteRune(r)
+			dash = false
+		} else if !dash && sb.Len() > 0 {
+			sb.WriteByte('-')
+			dash = true
+		}
+	}
+
+	s := strings.TrimSuffix(sb.String(), "-")
+	if len(s) > 50 {
+		s = strings.TrimSuffix(s[:50], "-")
+	}
+	if s == "" {
+		return "untitled"
+	}
+	return s
+}
+
+// limiter spaces requests at least interval apart across goroutines.
+type limiter struct {
+	ticker *time.Ticker
+}
+
+func newLimiter(interval time.Duration) *limiter {
+	if interval <= 0 {
+		return &limiter{}
+	}
+	return &limiter{ticker: time.NewTicker(interval)}
+}
+
+// wait blocks until the next request may be sent.
+func (l *limiter) wait(ctx context.Context) error {
+	if l.ticker == nil {
+		return nil
+	}
+	select {
+	case <-l.ticker.C:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+func (l *limiter) stop() {
+	if l.ticker != nil {
+		l.ticker.Stop()
+	}
+}
@@ -0,0 +1,341 @@
+package geometry
+
+import (
+	"fmt"
+	"math"
+	"strings"
+
+	"google.golang.org/api/slides/v1"
+)
+
+// Conversion factors between supported units and points.
+const (
+	EMUPerPT = 12700.0
+	PTPerIN  = 72.0
+	PTPerCM  = PTPerIN / 2.54
+)
+
+// Units lists the measurement units accepted by ToPT.
+var Units = []string{"PT", "EMU", "IN", "CM"}
+
+// Box is an axis-aligned rectangle in PT.
+type Box struct {
+	X      float64 `json:"x"`
+	Y      float64 `json:"y"`
+	Width  float64 `json:"width"`
+	Height float64 `json:"height"`
+}
+
+// ToPT converts a value expressed in unit (PT, EMU, IN or CM) to points.
+func ToPT(value float64, unit string) (float64, error) {
+	switch strings.ToUpper(unit) {
+	case "PT", "":
+		return value, nil
+	case "EMU":
+		return value / EMUPerPT, nil
+	case "IN":
+		return value * PTPerIN, nil
+	case "CM":
+		return value * PTPerCM, nil
+	default:
+		return 0, fmt.Errorf("invalid unit %q (expected one of %s)", unit, strings.Join(Units, ", "))
+	}
+}
+
+// BoxFromUnit builds a Box from coordinates expressed in unit.
+func BoxFromUnit(x, y, width, height float64, unit string) (Box, error) {
+	values := []float64{x, y, width, height}
+	for i, v := range values {
+		pt, err := ToPT(v, unit)
+		if err != nil {
+			return Box{}, err
+		}
+		values[i] = pt
+	}
+
+	if values[2] <= 0 || values[3] <= 0 {
+		return Box{}, fmt.Errorf("width and height must be positive")
+	}
+
+	return Box{X: values[0], Y: values[1], Width: values[2], Height: values[3]}, nil
+}
+
+// ElementProperties returns the properties placing a new element at box on a page.
+func ElementProperties(pageObjectID string, box Box) *slides.PageElementProperties {
+	return &slides.PageElementProperties{
+		PageObjectId: pageObjectID,
+		Size: &slides.Size{
+			Width:  &slides.Dimension{Magnitude: box.Width, Unit: "PT"},
+			Height: &slides.Dimension{Magnitude: box.Height, Unit: "PT"},
+		},
+		Transform: Transform(box, 0),
+	}
+}
+
+// Transform returns the transform placing an element of the box size at the
+// box position, rotated clockwise by degrees around its center.
+func Transform(box Box, degrees float64) *slides.AffineTransform {
+	if degrees == 0 {
+		return &slides.AffineTransform{
+			ScaleX:     1.0,
+			ScaleY:     1.0,
+			TranslateX: box.X,
+			TranslateY: box.Y,
+			Unit:       "PT",
+		}
+	}
+
+	rad := degrees * math.Pi / 180
+	cos, sin := math.Cos(rad), math.Sin(rad)
+	cx, cy := box.X+box.Width/2, box.Y+box.Height/2
+
+	return &slides.AffineTransform{
+		ScaleX:     cos,
+		ShearX:     -sin,
+		ShearY:     sin,
+		ScaleY:     cos,
+		TranslateX: cx - (cos*box.Width/2 - sin*box.Height/2),
+		TranslateY: cy - (sin*box.Width/2 + cos*box.Height/2),
+		Unit:       "PT",
+	}
+}
+
+// Matrix is an affine transform whose translation is expressed in PT:
+//
+//	x' = ScaleX*x + ShearX*y + TranslateX
+//	y' = ShearY*x + ScaleY*y + TranslateY
+type Matrix struct {
+	ScaleX     float64
+	ShearX     float64
+	ShearY     float64
+	ScaleY     float64
+	TranslateX float64
+	TranslateY float64
+}
+
+// Identity is the transform that leaves points unchanged.
+var Identity = Matrix{ScaleX: 1, ScaleY: 1}
+
+// FromTransform converts an API transform to a Matrix in PT. A nil transform
+// is the identity.
+func FromTransform(t *slides.AffineTransform) Matrix {
+	if t == nil {
+		return Identity
+	}
+
+	factor := 1.0
+	if t.Unit != "PT" {
+		factor = 1 / EMUPerPT
+	}
+
+	return Matrix{
+		ScaleX:     t.ScaleX,
+		ShearX:     t.ShearX,
+		ShearY:     t.ShearY,
+		ScaleY:     t.ScaleY,
+		TranslateX: t.TranslateX * factor,
+		TranslateY: t.TranslateY * factor,
+	}
+}
+
+// Transform converts the matrix to an API transform in PT.
+func (m Matrix) Transform() *slides.AffineTransform {
+	return &slides.AffineTransform{
+		ScaleX:     m.ScaleX,
+		ShearX:     m.ShearX,
+		ShearY:     m.ShearY,
+		ScaleY:     m.ScaleY,
+		TranslateX: m.TranslateX,
+		TranslateY: m.TranslateY,
+		Unit:       "PT",
+	}
+}
+
+// Multiply returns the transform applying n first and then m.
+func (m Matrix) Multiply(n Matrix) Matrix {
+	return Matrix{
+		ScaleX:     m.ScaleX*n.ScaleX + m.ShearX*n.ShearY,
+		ShearX:     m.ScaleX*n.ShearX + m.ShearX*n.ScaleY,
+		ShearY:     m.ShearY*n.ScaleX + m.ScaleY*n.ShearY,
+		ScaleY:     m.ShearY*n.ShearX + m.ScaleY*n.ScaleY,
+		TranslateX: m.ScaleX*n.TranslateX + m.ShearX*n.TranslateY + m.TranslateX,
+		TranslateY: m.ShearY*n.TranslateX + m.ScaleY*n.TranslateY + m.TranslateY,
+	}
+}
+
+// Inverse returns the transform undoing m. A degenerate matrix yields the identity.
+func (m Matrix) Inverse() Matrix {
+	det := m.ScaleX*m.ScaleY - m.ShearX*m.ShearY
+	if det == 0 {
+		return Identity
+	}
+
+	inv := Matrix{
+		ScaleX: m.ScaleY / det,
+		ShearX: -m.ShearX / det,
+		ShearY: -m.ShearY / det,
+		ScaleY: m.ScaleX / det,
+	}
+	inv.TranslateX = -(inv.ScaleX*m.TranslateX + inv.ShearX*m.TranslateY)
+	inv.TranslateY = -(inv.ShearY*m.TranslateX + inv.ScaleY*m.TranslateY)
+	return inv
+}
+
+// Rotation returns the clockwise rotation of m in degrees.
+func (m Matrix) Rotation() float64 {
+	return math.Atan2(m.ShearY, m.ScaleX) * 180 / math.Pi
+}
+
+// Translation returns a transform moving points by (dx, dy).
+func Translation(dx, dy float64) Matrix {
+	return Matrix{ScaleX: 1, ScaleY: 1, TranslateX: dx, TranslateY: dy}
+}
+
+// Scaling returns a transform scaling points by (sx, sy) around the origin.
+func Scaling(sx, sy float64) Matrix {
+	return Matrix{ScaleX: sx, ScaleY: sy}
+}
+
+// RotationAround returns a transform rotating points clockwise by degrees
+// around (cx, cy).
+func RotationAround(degrees, cx, cy float64) Matrix {
+	rad := degrees * math.Pi / 180
+	cos, sin := math.Cos(rad), math.Sin(rad)
+	rotation := Matrix{ScaleX: cos, ShearX: -sin, ShearY: sin, ScaleY: cos}
+	return Translation(cx, cy).Multiply(rotation).Multiply(Translation(-cx, -cy))
+}
+
+// Apply transforms the point (x, y).
+func (m Matrix) Apply(x, y float64) (float64, float64) {
+	return m.ScaleX*x + m.ShearX*y + m.TranslateX, m.ShearY*x + m.ScaleY*y + m.TranslateY
+}
+
+// DimensionPT converts an API dimension to PT. A nil dimension is zero.
+func DimensionPT(d *slides.Dimension) float64 {
+	if d == nil {
+		return 0
+	}
+	if d.Unit == "PT" {
+		return d.Magnitude
+	}
+	return d.Magnitude / EMUPerPT
+}
+
+// SizePT returns the width and height of an API size in PT.
+func SizePT(size *slides.Size) (float64, float64) {
+	if size == nil {
+		return 0, 0
+	}
+	return DimensionPT(size.Width), DimensionPT(size.Height)
+}
+
+// Bounds returns the axis-aligned bounding box of an element of the given
+// size once transformed by m.
+func Bounds(size *slides.Size, m Matrix) Box {
+	width, height := SizePT(size)
+
+	minX, minY := math.Inf(1), math.Inf(1)
+	maxX, maxY := math.Inf(-1), math.Inf(-1)
+	for _, corner := range [][2]float64{{0, 0}, {width, 0}, {0, height}, {width, height}} {
+		x, y := m.Apply(corner[0], corner[1])
+		minX, maxX = math.Min(minX, x), math.Max(maxX, x)
+		minY, maxY = math.Min(minY, y), math.Max(maxY, y)
+	}
+
+	return Box{X: minX, Y: minY, Width: maxX - minX, Height: maxY - minY}
+}
+
+// Center returns the center point of the box.
+func (b Box) Center() (float64, float64) {
+	return b.X + b.Width/2, b.Y + b.Height/2
+}
+
+// Placement is a page element of a slide together with its absolute transform,
+// which composes the transforms of all its parent groups.
+type Placement struct {
+	Element       *slides.PageElement
+	SlideIndex    int
+	PageObjectID  string
+	ParentGroupID string
+	Depth         int
+	// Parent is the absolute transform of the enclosing groups.
+	Parent Matrix
+	// Absolute is Parent composed with the element's own transform.
+	Absolute Matrix
+}
+
+// Bounds returns the absolute bounding box of the placed element in PT. Groups
+// have no size of their own and cover the union of their children.
+func (p Placement) Bounds() Box {
+	return elementBounds(p.Element, p.Absolute)
+}
+
+// elementBounds computes the bounding box of an element with absolute transform m.
+func elementBounds(element *slides.PageElement, m Matrix) Box {
+	if element.ElementGroup == nil || element.Size != nil {
+		return Bounds(element.Size, m)
+	}
+
+	var boxes []Box
+	for _, child := range element.ElementGroup.Children {
+		boxes = append(boxes, elementBounds(child, m.Multiply(FromTransform(child.Transform))))
+	}
+	return Union(boxes...)
+}
+
+// Union returns the smallest box containing every given box.
+func Union(boxes ...Box) Box {
+	if len(boxes) == 0 {
+		return Box{}
+	}
+
+	minX, minY := boxes[0].X, boxes[0].Y
+	maxX, maxY := boxes[0].X+boxes[0].Width, boxes[0].Y+boxes[0].Height
+	for _, b := range boxes[1:] {
+		minX, minY = math.Min(minX, b.X), math.Min(minY, b.Y)
+		maxX, maxY = math.Max(maxX, b.X+b.Width), math.Max(maxY, b.Y+b.Height)
+	}
+
+	return Box{X: minX, Y: minY, Width: maxX - minX, Height: maxY - minY}
+}
+
+// Walk lists every page element of every slide in document order, descending
+// into groups. Each group precedes its children.
+func Walk(presentation *slides.Presentation) []Placement {
+	var placements []Placement
+	for slideIdx, slide := range presentation.Slides {
+		placements = walkElements(placements, slide.PageElements, slideIdx, slide.ObjectId, "", 0, Identity)
+	}
+	return placements
+}
+
+// walkElements appends the placements of elements and their group children.
+func walkElements(placements []Placement, elements []*slides.PageElement, slideIndex int, pageObjectID string, parentGroupID string, depth int, parent Matrix) []Placement {
+	for _, element := range elements {
+		absolute := parent.Multiply(FromTransform(element.Transform))
+		placements = append(placements, Placement{
+			Element:       element,
+			SlideIndex:    slideIndex,
+			PageObjectID:  pageObjectID,
+			ParentGroupID: parentGroupID,
+			Depth:         depth,
+			Parent:        parent,
+			Absolute:      absolute,
+		})
+
+		if element.ElementGroup != nil {
+			placements = walkElements(placements, element.ElementGroup.Children, slideIndex, pageObjectID, element.ObjectId, depth+1, absolute)
+		}
+	}
+	return placements
+}
+
+// Locate finds a slide page element by object ID.
+func Locate(presentation *slides.Presentation, objectID string) (Placement, bool) {
+	for _, placement := range Walk(presentation) {
+		if placement.Element.ObjectId == objectID {
+			return placement, true
+		}
+	}
+	return Placement{}, false
+}
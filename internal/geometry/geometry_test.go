@@ -0,0 +1,206 @@
+package geometry
+
+import (
+	"math"
+	"testing"
+
+	"google.golang.org/api/slides/v1"
+)
+
+const epsilon = 1e-9
+
+func matrixEqual(a, b Matrix) bool {
+	return math.Abs(a.ScaleX-b.ScaleX) < epsilon &&
+		math.Abs(a.ShearX-b.ShearX) < epsilon &&
+		math.Abs(a.ShearY-b.ShearY) < epsilon &&
+		math.Abs(a.ScaleY-b.ScaleY) < epsilon &&
+		math.Abs(a.TranslateX-b.TranslateX) < epsilon &&
+		math.Abs(a.TranslateY-b.TranslateY) < epsilon
+}
+
+func boxEqual(a, b Box) bool {
+	return math.Abs(a.X-b.X) < epsilon &&
+		math.Abs(a.Y-b.Y) < epsilon &&
+		math.Abs(a.Width-b.Width) < epsilon &&
+		math.Abs(a.Height-b.Height) < epsilon
+}
+
+func TestToPT(t *testing.T) {
+	tests := []struct {
+		value   float64
+		unit    string
+		want    float64
+		wantErr bool
+	}{
+		{value: 10, unit: "PT", want: 10},
+		{value: 10, unit: "", want: 10},
+		{value: 12700, unit: "EMU", want: 1},
+		{value: 1, unit: "in", want: 72},
+		{value: 2.54, unit: "CM", want: 72},
+		{value: 1, unit: "PX", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		got, err := ToPT(tt.value, tt.unit)
+		if tt.wantErr {
+			if err == nil {
+				t.Errorf("ToPT(%v, %q) succeeded, want error", tt.value, tt.unit)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("ToPT(%v, %q): %v", tt.value, tt.unit, err)
+			continue
+		}
+		if math.Abs(got-tt.want) > epsilon {
+			t.Errorf("ToPT(%v, %q) = %v, want %v", tt.value, tt.unit, got, tt.want)
+		}
+	}
+}
+
+func TestMultiply(t *testing.T) {
+	tests := []struct {
+		name string
+		m, n Matrix
+		want Matrix
+	}{
+		{name: "identity", m: Identity, n: Translation(3, 4), want: Translation(3, 4)},
+		{name: "translations add", m: Translation(1, 2), n: Translation(3, 4), want: Translation(4, 6)},
+		{name: "scale after translation", m: Scaling(2, 3), n: Translation(1, 1), want: Matrix{ScaleX: 2, ScaleY: 3, TranslateX: 2, TranslateY: 3}},
+		{name: "translation after scale", m: Translation(1, 1), n: Scaling(2, 3), want: Matrix{ScaleX: 2, ScaleY: 3, TranslateX: 1, TranslateY: 1}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.m.Multiply(tt.n); !matrixEqual(got, tt.want) {
+				t.Errorf("Multiply = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestMultiplyAppliesRightFirst(t *testing.T) {
+	m := RotationAround(90, 0, 0)
+	n := Translation(10, 0)
+
+	x, y := m.Multiply(n).Apply(0, 0)
+	wantX, wantY := m.Apply(n.Apply(0, 0))
+	if math.Abs(x-wantX) > epsilon || math.Abs(y-wantY) > epsilon {
+		t.Errorf("Multiply(n).Apply = (%v, %v), want (%v, %v)", x, y, wantX, wantY)
+	}
+}
+
+func TestInverse(t *testing.T) {
+	tests := []struct {
+		name string
+		m    Matrix
+	}{
+		{name: "translation", m: Translation(5, -7)},
+		{name: "scaling", m: Scaling(2, 0.5)},
+		{name: "rotation", m: RotationAround(30, 100, 50)},
+		{name: "shear and translation", m: Matrix{ScaleX: 1, ShearX: 0.5, ShearY: 0.25, ScaleY: 2, TranslateX: 10, TranslateY: 20}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.m.Multiply(tt.m.Inverse()); !matrixEqual(got, Identity) {
+				t.Errorf("m * m^-1 = %+v, want identity", got)
+			}
+			if got := tt.m.Inverse().Multiply(tt.m); !matrixEqual(got, Identity) {
+				t.Errorf("m^-1 * m = %+v, want identity", got)
+			}
+		})
+	}
+}
+
+func TestInverseDegenerate(t *testing.T) {
+	if got := Scaling(0, 1).Inverse(); !matrixEqual(got, Identity) {
+		t.Errorf("Inverse of a degenerate matrix = %+v, want identity", got)
+	}
+}
+
+func TestRotation(t *testing.T) {
+	for _, degrees := range []float64{0, 45, 90, -30} {
+		if got := RotationAround(degrees, 10, 10).Rotation(); math.Abs(got-degrees) > epsilon {
+			t.Errorf("Rotation of a %v° rotation = %v", degrees, got)
+		}
+	}
+}
+
+func TestFromTransform(t *testing.T) {
+	emu := &slides.AffineTransform{ScaleX: 1, ScaleY: 1, TranslateX: 127000, TranslateY: 254000, Unit: "EMU"}
+	if got := FromTransform(emu); !matrixEqual(got, Translation(10, 20)) {
+		t.Errorf("FromTransform(EMU) = %+v, want translation (10, 20)", got)
+	}
+
+	if got := FromTransform(nil); !matrixEqual(got, Identity) {
+		t.Errorf("FromTransform(nil) = %+v, want identity", got)
+	}
+}
+
+func TestBounds(t *testing.T) {
+	size := &slides.Size{
+		Width:  &slides.Dimension{Magnitude: 100, Unit: "PT"},
+		Height: &slides.Dimension{Magnitude: 50, Unit: "PT"},
+	}
+
+	tests := []struct {
+		name string
+		m    Matrix
+		want Box
+	}{
+		{name: "identity", m: Identity, want: Box{Width: 100, Height: 50}},
+		{name: "translated", m: Translation(10, 20), want: Box{X: 10, Y: 20, Width: 100, Height: 50}},
+		{name: "scaled", m: Scaling(2, 0.5), want: Box{Width: 200, Height: 25}},
+		{name: "rotated around center", m: RotationAround(90, 50, 25), want: Box{X: 25, Y: -25, Width: 50, Height: 100}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Bounds(size, tt.m); !boxEqual(got, tt.want) {
+				t.Errorf("Bounds = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestPlacementBoundsOfGroup(t *testing.T) {
+	square := func(id string, x, y float64) *slides.PageElement {
+		return &slides.PageElement{
+			ObjectId: id,
+			Size: &slides.Size{
+				Width:  &slides.Dimension{Magnitude: 10, Unit: "PT"},
+				Height: &slides.Dimension{Magnitude: 10, Unit: "PT"},
+			},
+			Transform: Translation(x, y).Transform(),
+		}
+	}
+
+	presentation := &slides.Presentation{Slides: []*slides.Page{{
+		ObjectId: "slide",
+		PageElements: []*slides.PageElement{{
+			ObjectId:     "group",
+			Transform:    Translation(100, 100).Transform(),
+			ElementGroup: &slides.Group{Children: []*slides.PageElement{square("a", 0, 0), square("b", 30, 20)}},
+		}},
+	}}}
+
+	group, ok := Locate(presentation, "group")
+	if !ok {
+		t.Fatal("group not found")
+	}
+	if got, want := group.Bounds(), (Box{X: 100, Y: 100, Width: 40, Height: 30}); !boxEqual(got, want) {
+		t.Errorf("group bounds = %+v, want %+v", got, want)
+	}
+
+	child, ok := Locate(presentation, "b")
+	if !ok {
+		t.Fatal("child not found")
+	}
+	if child.ParentGroupID != "group" || child.Depth != 1 {
+		t.Errorf("child placement = parent %q depth %d, want group at depth 1", child.ParentGroupID, child.Depth)
+	}
+	if got, want := child.Bounds(), (Box{X: 130, Y: 120, Width: 10, Height: 10}); !boxEqual(got, want) {
+		t.Errorf("child bounds = %+v, want %+v", got, want)
+	}
+}
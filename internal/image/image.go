@@ -0,0 +1,288 @@
+package image
+
+import (
+	"context"
+	"fmt"
+	goimage "image"
+	_ "image/gif"
+	_ "image/jpeg"
+	_ "image/png"
+	"io"
+	"mime"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"google.golang.org/api/drive/v3"
+	"google.golang.org/api/slides/v1"
+
+	"google-slide-manager/internal/geometry"
+)
+
+// Fit modes for sizing an image inside its target box.
+const (
+	FitNone    = ""
+	FitContain = "contain"
+	FitCover   = "cover"
+)
+
+// Service wraps Google Slides and Drive services for image operations.
+type Service struct {
+	slidesService *slides.Service
+	driveService  *drive.Service
+}
+
+// Options describes where and how an image is placed.
+type Options struct {
+	// Box is the target area of the image in PT.
+	Box geometry.Box
+	// Fit sizes the image from its pixel dimensions: contain keeps the whole
+	// image inside Box, cover fills Box and lets the image overflow it.
+	// Without a fit mode, the image element gets exactly the Box size.
+	Fit string
+	// KeepUpload keeps the Drive copy of an uploaded local file, which stays
+	// readable by anyone with the link. By default it is deleted once the
+	// image has been inserted; the presentation keeps its own copy.
+	KeepUpload bool
+}
+
+// httpClient downloads remote images to read their dimensions.
+var httpClient = &http.Client{Timeout: 30 * time.Second}
+
+// NewService creates a new image service.
+func NewService(ctx context.Context, slidesService *slides.Service, driveService *drive.Service) *Service {
+	return &Service{
+		slidesService: slidesService,
+		driveService:  driveService,
+	}
+}
+
+// generateObjectID generates a unique object ID using timestamp.
+func generateObjectID(prefix string) string {
+	return fmt.Sprintf("%s_%d", prefix, time.Now().UnixNano())
+}
+
+// Add inserts an image from a URL or local file on a slide.
+func (s *Service) Add(ctx context.Context, presentationID string, slideIndex int, source string, opts Options) (string, error) {
+	if err := validateFit(opts.Fit); err != nil {
+		return "", err
+	}
+
+	presentation, err := s.slidesService.Presentations.Get(presentationID).Do()
+	if err != nil {
+		return "", fmt.Errorf("error getting presentation: %w", err)
+	}
+
+	if slideIndex < 0 || slideIndex >= len(presentation.Slides) {
+		return "", fmt.Errorf("slide index out of range")
+	}
+
+	box := opts.Box
+	if opts.Fit != FitNone {
+		width, height, err := pixelSize(ctx, source)
+		if err != nil {
+			return "", err
+		}
+		box = fitBox(opts.Box, width, height, opts.Fit)
+	}
+
+	url, cleanup, err := s.resolveURL(ctx, source, opts.KeepUpload)
+	if err != nil {
+		return "", err
+	}
+	defer cleanup()
+
+	slideID := presentation.Slides[slideIndex].ObjectId
+	imageID := generateObjectID("image")
+
+	requests := []*slides.Request{
+		{
+			CreateImage: &slides.CreateImageRequest{
+				ObjectId:          imageID,
+				Url:               url,
+				ElementProperties: geometry.ElementProperties(slideID, box),
+			},
+		},
+	}
+
+	_, err = s.slidesService.Presentations.BatchUpdate(presentationID, &slides.BatchUpdatePresentationRequest{
+		Requests: requests,
+	}).Do()
+
+	if err != nil {
+		return "", fmt.Errorf("error adding image: %w", err)
+	}
+
+	return imageID, nil
+}
+
+// Replace replaces the content of an existing image, keeping its position and
+// size. Fit contain maps to CENTER_INSIDE and cover to CENTER_CROP. See
+// Options.KeepUpload for keepUpload.
+func (s *Service) Replace(ctx context.Context, presentationID string, objectID string, source string, fit string, keepUpload bool) error {
+	if err := validateFit(fit); err != nil {
+		return err
+	}
+
+	method := "CENTER_INSIDE"
+	if fit == FitCover {
+		method = "CENTER_CROP"
+	}
+
+	url, cleanup, err := s.resolveURL(ctx, source, keepUpload)
+	if err != nil {
+		return err
+	}
+	defer cleanup()
+
+	requests := []*slides.Request{
+		{
+			ReplaceImage: &slides.ReplaceImageRequest{
+				ImageObjectId:      objectID,
+				Url:                url,
+				ImageReplaceMethod: method,
+			},
+		},
+	}
+
+	_, err = s.slidesService.Presentations.BatchUpdate(presentationID, &slides.BatchUpdatePresentationRequest{
+		Requests: requests,
+	}).Do()
+
+	if err != nil {
+		return fmt.Errorf("error replacing image: %w", err)
+	}
+
+	return nil
+}
+
+// resolveURL returns a URL the Slides API can fetch. Local files are uploaded
+// to Drive and shared by link; the returned cleanup deletes the upload, or,
+// when keep is true, reports its file ID and that it is publicly readable.
+func (s *Service) resolveURL(ctx context.Context, source string, keep bool) (string, func(), error) {
+	noop := func() {}
+
+	if isURL(source) {
+		return source, noop, nil
+	}
+
+	f, err := os.Open(source)
+	if err != nil {
+		return "", noop, fmt.Errorf("error opening image: %w", err)
+	}
+	defer f.Close()
+
+	file := &drive.File{
+		Name:     filepath.Base(source),
+		MimeType: mime.TypeByExtension(strings.ToLower(filepath.Ext(source))),
+	}
+
+	uploaded, err := s.driveService.Files.Create(file).
+		Media(f).
+		Fields("id, webContentLink").
+		SupportsAllDrives(true).
+		Do()
+	if err != nil {
+		return "", noop, fmt.Errorf("error uploading image to Drive: %w", err)
+	}
+
+	deleteUpload := func() {
+		if err := s.driveService.Files.Delete(uploaded.Id).SupportsAllDrives(true).Do(); err != nil {
+			fmt.Fprintf(os.Stderr, "⚠️  Could not delete uploaded image %s: %v\n", uploaded.Id, err)
+		}
+	}
+
+	_, err = s.driveService.Permissions.Create(uploaded.Id, &drive.Permission{
+		Type: "anyone",
+		Role: "reader",
+	}).SupportsAllDrives(true).Do()
+	if err != nil {
+		deleteUpload()
+		return "", noop, fmt.Errorf("error sharing uploaded image: %w", err)
+	}
+
+	if keep {
+		return uploaded.WebContentLink, func() {
+			fmt.Fprintf(os.Stderr, "⚠️  Kept Drive upload %s: anyone with the link can read it\n", uploaded.Id)
+		}, nil
+	}
+
+	return uploaded.WebContentLink, deleteUpload, nil
+}
+
+// pixelSize reads the pixel dimensions of a PNG, JPEG or GIF from a URL or file.
+func pixelSize(ctx context.Context, source string) (int, int, error) {
+	var r io.ReadCloser
+
+	if isURL(source) {
+		req, err := http.NewRequestWithContext(ctx, http.MethodGet, source, nil)
+		if err != nil {
+			return 0, 0, fmt.Errorf("error downloading image: %w", err)
+		}
+		resp, err := httpClient.Do(req)
+		if err != nil {
+			return 0, 0, fmt.Errorf("error downloading image: %w", err)
+		}
+		if resp.StatusCode != http.StatusOK {
+			resp.Body.Close()
+			return 0, 0, fmt.Errorf("error downloading image: %s", resp.Status)
+		}
+		r = resp.Body
+	} else {
+		f, err := os.Open(source)
+		if err != nil {
+			return 0, 0, fmt.Errorf("error opening image: %w", err)
+		}
+		r = f
+	}
+	defer r.Close()
+
+	config, _, err := goimage.DecodeConfig(r)
+	if err != nil {
+		return 0, 0, fmt.Errorf("error reading image dimensions (PNG, JPEG or GIF expected): %w", err)
+	}
+
+	return config.Width, config.Height, nil
+}
+
+// fitBox sizes an image of the given pixel dimensions to box and centers it.
+func fitBox(box geometry.Box, pixelWidth int, pixelHeight int, fit string) geometry.Box {
+	if pixelWidth <= 0 || pixelHeight <= 0 {
+		return box
+	}
+
+	scaleX := box.Width / float64(pixelWidth)
+	scaleY := box.Height / float64(pixelHeight)
+
+	scale := min(scaleX, scaleY)
+	if fit == FitCover {
+		scale = max(scaleX, scaleY)
+	}
+
+	width := float64(pixelWidth) * scale
+	height := float64(pixelHeight) * scale
+
+	return geometry.Box{
+		X:      box.X + (box.Width-width)/2,
+		Y:      box.Y + (box.Height-height)/2,
+		Width:  width,
+		Height: height,
+	}
+}
+
+// validateFit checks that fit is a supported fit mode.
+func validateFit(fit string) error {
+	switch fit {
+	case FitNone, FitContain, FitCover:
+		return nil
+	default:
+		return fmt.Errorf("invalid fit %q (expected contain or cover)", fit)
+	}
+}
+
+// isURL reports whether source is an HTTP(S) URL rather than a local path.
+func isURL(source string) bool {
+	return strings.HasPrefix(source, "http://") || strings.HasPrefix(source, "https://")
+}
@@ -0,0 +1,215 @@
+package inspect
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"strings"
+	"text/tabwriter"
+	"unicode/utf8"
+
+	"google.golang.org/api/slides/v1"
+
+	"google-slide-manager/internal/geometry"
+	"google-slide-manager/internal/text"
+)
+
+// previewRunes is the maximum length of text previews.
+const previewRunes = 40
+
+// Service wraps Google Slides service for layout inspection.
+type Service struct {
+	slidesService *slides.Service
+}
+
+// NewService creates a new inspect service.
+func NewService(ctx context.Context, slidesService *slides.Service) *Service {
+	return &Service{
+		slidesService: slidesService,
+	}
+}
+
+// Slide is the element tree of a slide.
+type Slide struct {
+	SlideIndex int        `json:"slide_index"`
+	ObjectID   string     `json:"object_id"`
+	Elements   []*Element `json:"elements"`
+}
+
+// Element describes a page element and its absolute geometry in PT.
+type Element struct {
+	ObjectID        string       `json:"object_id"`
+	Type            string       `json:"type"`
+	ShapeType       string       `json:"shape_type,omitempty"`
+	PlaceholderType string       `json:"placeholder_type,omitempty"`
+	Rows            int64        `json:"rows,omitempty"`
+	Columns         int64        `json:"columns,omitempty"`
+	Bounds          geometry.Box `json:"bounds"`
+	Rotation        float64      `json:"rotation"`
+	Text            string       `json:"text,omitempty"`
+	Children        []*Element   `json:"children,omitempty"`
+}
+
+// Inspect returns the element trees of all slides, or of a single slide when
+// slideIndex is not nil. Bounding boxes are absolute: they compose the size of
+// each element with its transform and the transforms of its parent groups.
+func (s *Service) Inspect(ctx context.Context, presentationID string, slideIndex *int) ([]Slide, error) {
+	presentation, err := s.slidesService.Presentations.Get(presentationID).Do()
+	if err != nil {
+		return nil, fmt.Errorf("error getting presentation: %w", err)
+	}
+
+	if slideIndex != nil && (*slideIndex < 0 || *slideIndex >= len(presentation.Slides)) {
+		return nil, fmt.Errorf("slide index out of range")
+	}
+
+	result := make([]Slide, 0, len(presentation.Slides))
+	positions := map[int]int{}
+	for slideIdx, slide := range presentation.Slides {
+		if slideIndex == nil || *slideIndex == slideIdx {
+			positions[slideIdx] = len(result)
+			result = append(result, Slide{SlideIndex: slideIdx, ObjectID: slide.ObjectId, Elements: []*Element{}})
+		}
+	}
+
+	elements := map[string]*Element{}
+	for _, placement := range geometry.Walk(presentation) {
+		if slideIndex != nil && *slideIndex != placement.SlideIndex {
+			continue
+		}
+
+		element := describe(placement)
+		elements[element.ObjectID] = element
+
+		if parent, ok := elements[placement.ParentGroupID]; ok {
+			parent.Children = append(parent.Children, element)
+		} else {
+			slide := &result[positions[placement.SlideIndex]]
+			slide.Elements = append(slide.Elements, element)
+		}
+	}
+
+	return result, nil
+}
+
+// WriteTable writes slides as an indented table, one row per element.
+func WriteTable(w io.Writer, slideTrees []Slide) error {
+	tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+	for i, slide := range slideTrees {
+		if i > 0 {
+			fmt.Fprintln(tw)
+		}
+		fmt.Fprintf(tw, "Slide %d (%s)\n", slide.SlideIndex, slide.ObjectID)
+		fmt.Fprintln(tw, "OBJECT ID\tTYPE\tPLACEHOLDER\tX\tY\tWIDTH\tHEIGHT\tROTATION\tTEXT")
+		writeRows(tw, slide.Elements, 0)
+	}
+	return tw.Flush()
+}
+
+// writeRows writes elements and their children, indenting object IDs by depth.
+func writeRows(w io.Writer, elements []*Element, depth int) {
+	for _, e := range elements {
+		kind := e.Type
+		switch {
+		case e.ShapeType != "":
+			kind += " " + e.ShapeType
+		case e.Rows > 0:
+			kind += fmt.Sprintf(" %dx%d", e.Rows, e.Columns)
+		}
+
+		fmt.Fprintf(w, "%s%s\t%s\t%s\t%.1f\t%.1f\t%.1f\t%.1f\t%.1f\t%s\n",
+			strings.Repeat("  ", depth), e.ObjectID, kind, e.PlaceholderType,
+			e.Bounds.X, e.Bounds.Y, e.Bounds.Width, e.Bounds.Height, e.Rotation, e.Text)
+
+		writeRows(w, e.Children, depth+1)
+	}
+}
+
+// describe builds the description of a placed element, without its children.
+func describe(placement geometry.Placement) *Element {
+	pe := placement.Element
+	element := &Element{
+		ObjectID: pe.ObjectId,
+		Bounds:   placement.Bounds(),
+		Rotation: placement.Absolute.Rotation(),
+	}
+
+	switch {
+	case pe.Shape != nil:
+		element.Type = "SHAPE"
+		element.ShapeType = pe.Shape.ShapeType
+		if pe.Shape.Placeholder != nil {
+			element.PlaceholderType = pe.Shape.Placeholder.Type
+		}
+		element.Text = preview(pe.Shape.Text)
+	case pe.Table != nil:
+		element.Type = "TABLE"
+		element.Rows = pe.Table.Rows
+		element.Columns = pe.Table.Columns
+		element.Text = tablePreview(pe.Table)
+	case pe.ElementGroup != nil:
+		element.Type = "GROUP"
+	case pe.Image != nil:
+		element.Type = "IMAGE"
+		if pe.Image.Placeholder != nil {
+			element.PlaceholderType = pe.Image.Placeholder.Type
+		}
+	case pe.Line != nil:
+		element.Type = "LINE"
+	case pe.Video != nil:
+		element.Type = "VIDEO"
+	case pe.SheetsChart != nil:
+		element.Type = "SHEETS_CHART"
+	case pe.WordArt != nil:
+		element.Type = "WORD_ART"
+		element.Text = pe.WordArt.RenderedText
+	case pe.SpeakerSpotlight != nil:
+		element.Type = "SPEAKER_SPOTLIGHT"
+	default:
+		element.Type = "UNKNOWN"
+	}
+
+	return element
+}
+
+// preview returns the beginning of a text on a single line.
+func preview(content *slides.TextContent) string {
+	return truncate(text.PlainText(content))
+}
+
+// tablePreview returns the non-empty cells of the header row separated by
+// " | ", or the first non-empty cell of the table when the header row is
+// empty.
+func tablePreview(table *slides.Table) string {
+	if len(table.TableRows) == 0 {
+		return ""
+	}
+
+	var header []string
+	for _, cell := range table.TableRows[0].TableCells {
+		if cellText := strings.TrimSpace(text.PlainText(cell.Text)); cellText != "" {
+			header = append(header, cellText)
+		}
+	}
+	if len(header) > 0 {
+		return truncate(strings.Join(header, " | "))
+	}
+
+	for _, row := range table.TableRows[1:] {
+		for _, cell := range row.TableCells {
+			if cellText := text.PlainText(cell.Text); strings.TrimSpace(cellText) != "" {
+				return truncate(cellText)
+			}
+		}
+	}
+	return ""
+}
+
+// truncate collapses whitespace in s and cuts it to previewRunes.
+func truncate(s string) string {
+	line := strings.Join(strings.Fields(s), " ")
+	if utf8.RuneCountInString(line) > previewRunes {
+		line = string([]rune(line)[:previewRunes]) + "…"
+	}
+	return line
+}
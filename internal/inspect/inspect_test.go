@@ -0,0 +1,68 @@
+package inspect
+
+import (
+	"testing"
+
+	"google.golang.org/api/slides/v1"
+)
+
+// testTable builds a table from cell texts; an empty text leaves the cell
+// without text, as the API returns empty cells.
+func testTable(texts [][]string) *slides.Table {
+	table := &slides.Table{Rows: int64(len(texts))}
+	for _, row := range texts {
+		tableRow := &slides.TableRow{}
+		for _, value := range row {
+			cell := &slides.TableCell{}
+			if value != "" {
+				cell.Text = &slides.TextContent{TextElements: []*slides.TextElement{
+					{TextRun: &slides.TextRun{Content: value + "\n"}},
+				}}
+			}
+			tableRow.TableCells = append(tableRow.TableCells, cell)
+		}
+		table.TableRows = append(table.TableRows, tableRow)
+	}
+	return table
+}
+
+func TestTablePreview(t *testing.T) {
+	tests := []struct {
+		name  string
+		texts [][]string
+		want  string
+	}{
+		{
+			name:  "header row",
+			texts: [][]string{{"Region", "", "Q1"}, {"North", "", "100"}},
+			want:  "Region | Q1",
+		},
+		{
+			name:  "empty header row",
+			texts: [][]string{{"", ""}, {"", "North\nregion"}},
+			want:  "North region",
+		},
+		{
+			name:  "long header row",
+			texts: [][]string{{"Region name", "First quarter revenue", "Second quarter revenue"}},
+			want:  "Region name | First quarter revenue | Se…",
+		},
+		{
+			name:  "empty table",
+			texts: [][]string{{"", ""}, {"", ""}},
+			want:  "",
+		},
+		{
+			name: "no rows",
+			want: "",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tablePreview(testTable(tt.texts)); got != tt.want {
+				t.Errorf("tablePreview = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,286 @@
+package line
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"slices"
+	"strings"
+	"time"
+
+	"google.golang.org/api/slides/v1"
+
+	"google-slide-manager/internal/color"
+	"google-slide-manager/internal/geometry"
+)
+
+// Categories lists the line categories accepted by CreateLine.
+var Categories = []string{"STRAIGHT", "BENT", "CURVED"}
+
+// ArrowStyles lists the arrowhead styles of a line end.
+var ArrowStyles = []string{
+	"NONE", "STEALTH_ARROW", "FILL_ARROW", "FILL_CIRCLE", "FILL_SQUARE", "FILL_DIAMOND",
+	"OPEN_ARROW", "OPEN_CIRCLE", "OPEN_SQUARE", "OPEN_DIAMOND",
+}
+
+// DashStyles lists the line dash styles.
+var DashStyles = []string{"SOLID", "DOT", "DASH", "DASH_DOT", "LONG_DASH", "LONG_DASH_DOT"}
+
+// Service wraps Google Slides service for line operations.
+type Service struct {
+	slidesService *slides.Service
+}
+
+// Endpoints describes where a line starts and ends. When From or To is set,
+// that end is connected to the page element with this object ID and the
+// matching coordinates are ignored. Coordinates are in PT.
+type Endpoints struct {
+	X1   float64
+	Y1   float64
+	X2   float64
+	Y2   float64
+	From string
+	To   string
+}
+
+// Options describes the category and styling of a line. Empty strings, zero
+// values and nil pointers keep the API defaults. When both ends are connected
+// and no connection site is given, the line is rerouted to the closest sites.
+type Options struct {
+	Category   string
+	StartArrow string
+	EndArrow   string
+	DashStyle  string
+	Weight     float64
+	Color      string
+	FromSite   *int64
+	ToSite     *int64
+}
+
+// NewService creates a new line service.
+func NewService(ctx context.Context, slidesService *slides.Service) *Service {
+	return &Service{
+		slidesService: slidesService,
+	}
+}
+
+// generateObjectID generates a unique object ID using timestamp.
+func generateObjectID(prefix string) string {
+	return fmt.Sprintf("%s_%d", prefix, time.Now().UnixNano())
+}
+
+// Add creates a line or connector on a slide, styles it and connects it to
+// page elements in a single batch.
+func (s *Service) Add(ctx context.Context, presentationID string, slideIndex int, ends Endpoints, opts Options) (string, error) {
+	category, err := normalize(opts.Category, "STRAIGHT", Categories, "line category")
+	if err != nil {
+		return "", err
+	}
+
+	presentation, err := s.slidesService.Presentations.Get(presentationID).Do()
+	if err != nil {
+		return "", fmt.Errorf("error getting presentation: %w", err)
+	}
+
+	if slideIndex < 0 || slideIndex >= len(presentation.Slides) {
+		return "", fmt.Errorf("slide index out of range")
+	}
+
+	slideID := presentation.Slides[slideIndex].ObjectId
+
+	if ends.From != "" {
+		ends.X1, ends.Y1, err = connectionPoint(presentation, slideID, ends.From)
+		if err != nil {
+			return "", err
+		}
+	}
+
+	if ends.To != "" {
+		ends.X2, ends.Y2, err = connectionPoint(presentation, slideID, ends.To)
+		if err != nil {
+			return "", err
+		}
+	}
+
+	if ends.From != "" && ends.From == ends.To {
+		return "", fmt.Errorf("cannot connect %s to itself", ends.From)
+	}
+
+	properties, fields, err := opts.lineProperties(ends)
+	if err != nil {
+		return "", err
+	}
+
+	lineID := generateObjectID("line")
+
+	requests := []*slides.Request{
+		{
+			CreateLine: &slides.CreateLineRequest{
+				ObjectId:          lineID,
+				Category:          category,
+				ElementProperties: elementProperties(slideID, ends),
+			},
+		},
+	}
+
+	if len(fields) > 0 {
+		requests = append(requests, &slides.Request{
+			UpdateLineProperties: &slides.UpdateLinePropertiesRequest{
+				ObjectId:       lineID,
+				LineProperties: properties,
+				Fields:         strings.Join(fields, ","),
+			},
+		})
+	}
+
+	if ends.From != "" && ends.To != "" && opts.FromSite == nil && opts.ToSite == nil {
+		requests = append(requests, &slides.Request{
+			RerouteLine: &slides.RerouteLineRequest{ObjectId: lineID},
+		})
+	}
+
+	_, err = s.slidesService.Presentations.BatchUpdate(presentationID, &slides.BatchUpdatePresentationRequest{
+		Requests: requests,
+	}).Do()
+
+	if err != nil {
+		return "", fmt.Errorf("error adding line: %w", err)
+	}
+
+	return lineID, nil
+}
+
+// lineProperties converts the styling options and connections to
+// LineProperties and the matching field mask.
+func (o Options) lineProperties(ends Endpoints) (*slides.LineProperties, []string, error) {
+	properties := &slides.LineProperties{}
+	var fields []string
+
+	if o.StartArrow != "" {
+		arrow, err := normalize(o.StartArrow, "", ArrowStyles, "arrow style")
+		if err != nil {
+			return nil, nil, err
+		}
+		properties.StartArrow = arrow
+		fields = append(fields, "startArrow")
+	}
+
+	if o.EndArrow != "" {
+		arrow, err := normalize(o.EndArrow, "", ArrowStyles, "arrow style")
+		if err != nil {
+			return nil, nil, err
+		}
+		properties.EndArrow = arrow
+		fields = append(fields, "endArrow")
+	}
+
+	if o.DashStyle != "" {
+		dashStyle, err := normalize(o.DashStyle, "", DashStyles, "dash style")
+		if err != nil {
+			return nil, nil, err
+		}
+		properties.DashStyle = dashStyle
+		fields = append(fields, "dashStyle")
+	}
+
+	if o.Weight < 0 {
+		return nil, nil, fmt.Errorf("invalid line weight: %g", o.Weight)
+	}
+	if o.Weight > 0 {
+		properties.Weight = &slides.Dimension{Magnitude: o.Weight, Unit: "PT"}
+		fields = append(fields, "weight")
+	}
+
+	if o.Color != "" {
+		c, err := color.Parse(o.Color)
+		if err != nil {
+			return nil, nil, fmt.Errorf("invalid line color: %w", err)
+		}
+		properties.LineFill = &slides.LineFill{SolidFill: &slides.SolidFill{Color: c}}
+		fields = append(fields, "lineFill.solidFill.color")
+	}
+
+	if ends.From != "" {
+		properties.StartConnection = connection(ends.From, o.FromSite)
+		fields = append(fields, "startConnection")
+	}
+
+	if ends.To != "" {
+		properties.EndConnection = connection(ends.To, o.ToSite)
+		fields = append(fields, "endConnection")
+	}
+
+	return properties, fields, nil
+}
+
+// connection builds a line connection, defaulting to connection site 0.
+func connection(objectID string, site *int64) *slides.LineConnection {
+	c := &slides.LineConnection{
+		ConnectedObjectId: objectID,
+		ForceSendFields:   []string{"ConnectionSiteIndex"},
+	}
+	if site != nil {
+		c.ConnectionSiteIndex = *site
+	}
+	return c
+}
+
+// connectionPoint returns the center of a page element, used as the initial
+// line end before the connection is applied.
+func connectionPoint(presentation *slides.Presentation, slideID string, objectID string) (float64, float64, error) {
+	placement, ok := geometry.Locate(presentation, objectID)
+	if !ok {
+		return 0, 0, fmt.Errorf("element %s not found", objectID)
+	}
+
+	if placement.PageObjectID != slideID {
+		return 0, 0, fmt.Errorf("element %s is on slide %d, not on the target slide", objectID, placement.SlideIndex)
+	}
+
+	x, y := placement.Bounds().Center()
+	return x, y, nil
+}
+
+// elementProperties places a line from (X1, Y1) to (X2, Y2). A line runs from
+// the top-left to the bottom-right corner of its box, so negative scales flip
+// it for lines going left or up.
+func elementProperties(slideID string, ends Endpoints) *slides.PageElementProperties {
+	dx, dy := ends.X2-ends.X1, ends.Y2-ends.Y1
+
+	scaleX, scaleY := 1.0, 1.0
+	if dx < 0 {
+		scaleX = -1
+	}
+	if dy < 0 {
+		scaleY = -1
+	}
+
+	width := &slides.Dimension{Magnitude: math.Abs(dx), Unit: "PT", ForceSendFields: []string{"Magnitude"}}
+	height := &slides.Dimension{Magnitude: math.Abs(dy), Unit: "PT", ForceSendFields: []string{"Magnitude"}}
+
+	return &slides.PageElementProperties{
+		PageObjectId: slideID,
+		Size:         &slides.Size{Width: width, Height: height},
+		Transform: &slides.AffineTransform{
+			ScaleX:     scaleX,
+			ScaleY:     scaleY,
+			TranslateX: ends.X1,
+			TranslateY: ends.Y1,
+			Unit:       "PT",
+		},
+	}
+}
+
+// normalize upper-cases value and checks it against allowed, returning
+// fallback for empty values.
+func normalize(value string, fallback string, allowed []string, name string) (string, error) {
+	if value == "" {
+		return fallback, nil
+	}
+
+	normalized := strings.ToUpper(value)
+	if !slices.Contains(allowed, normalized) {
+		return "", fmt.Errorf("invalid %s %q (expected one of %s)", name, value, strings.Join(allowed, ", "))
+	}
+	return normalized, nil
+}
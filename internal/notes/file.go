@@ -0,0 +1,154 @@
+package notes
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+var (
+	slideHeading = regexp.MustCompile(`^## Slide (\d+)(?::\s*(.*))?$`)
+	titleHeading = regexp.MustCompile(`^## (.+)$`)
+	objectIDTag  = regexp.MustCompile(`^<!--\s*object_id:\s*(\S+)\s*-->$`)
+)
+
+// ReadFile reads notes entries from a JSON file (as written by
+// extract-all-notes) or, for .md and .markdown files, from Markdown.
+func ReadFile(path string) ([]SlideNotes, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("error opening notes file: %w", err)
+	}
+	defer f.Close()
+
+	switch strings.ToLower(filepath.Ext(path)) {
+	case ".md", ".markdown":
+		return ParseMarkdown(f)
+	default:
+		var entries []SlideNotes
+		if err := json.NewDecoder(f).Decode(&entries); err != nil {
+			return nil, fmt.Errorf("error parsing notes file %s: %w", path, err)
+		}
+		return entries, nil
+	}
+}
+
+// WriteMarkdown writes notes as Markdown: one "## Slide N: Title" section per
+// slide, numbered from 1, followed by an object ID comment and the notes text. Notes lines
+// that would read as a heading or an object ID comment are escaped with a
+// backslash, which ParseMarkdown removes.
+func WriteMarkdown(w io.Writer, allNotes []SlideNotes) error {
+	for i, entry := range allNotes {
+		if i > 0 {
+			fmt.Fprintln(w)
+		}
+
+		heading := "## Slide"
+		if entry.SlideIndex != nil {
+			heading += " " + strconv.Itoa(*entry.SlideIndex+1)
+		}
+		if entry.Title != "" {
+			heading += ": " + entry.Title
+		}
+		fmt.Fprintln(w, heading)
+
+		if entry.ObjectID != "" {
+			fmt.Fprintf(w, "<!-- object_id: %s -->\n", entry.ObjectID)
+		}
+
+		if entry.Notes != "" {
+			lines := strings.Split(entry.Notes, "\n")
+			for i, line := range lines {
+				if isStructural(strings.TrimLeft(line, `\`)) {
+					lines[i] = `\` + line
+				}
+			}
+			if _, err := fmt.Fprintf(w, "\n%s\n", strings.Join(lines, "\n")); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// ParseMarkdown parses notes written by WriteMarkdown. A section heading is
+// either "## Slide N" (1-based slide number, with an optional ": Title") or
+// "## Title" to match a slide by title; an "<!-- object_id: ID -->" line
+// right after the heading takes precedence over both. A backslash before a
+// notes line that would otherwise read as a heading or an object ID comment
+// is removed.
+func ParseMarkdown(r io.Reader) ([]SlideNotes, error) {
+	var entries []SlideNotes
+	var body []string
+	current := -1
+
+	flush := func() {
+		if current >= 0 {
+			entries[current].Notes = strings.TrimSpace(strings.Join(body, "\n"))
+		}
+		body = nil
+	}
+
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 0, 64*1024), 1024*1024)
+	lineNumber := 0
+	for scanner.Scan() {
+		line := scanner.Text()
+		lineNumber++
+
+		if m := slideHeading.FindStringSubmatch(line); m != nil {
+			flush()
+			number, _ := strconv.Atoi(m[1])
+			if number < 1 {
+				return nil, fmt.Errorf("line %d: slide numbers start at 1", lineNumber)
+			}
+			idx := number - 1
+			entries = append(entries, SlideNotes{SlideIndex: &idx, Title: strings.TrimSpace(m[2])})
+			current = len(entries) - 1
+			continue
+		}
+
+		if m := titleHeading.FindStringSubmatch(line); m != nil {
+			flush()
+			entries = append(entries, SlideNotes{Title: strings.TrimSpace(m[1])})
+			current = len(entries) - 1
+			continue
+		}
+
+		if m := objectIDTag.FindStringSubmatch(strings.TrimSpace(line)); m != nil && current >= 0 && strings.TrimSpace(strings.Join(body, "")) == "" {
+			entries[current].ObjectID = m[1]
+			continue
+		}
+
+		if current < 0 {
+			if strings.TrimSpace(line) != "" {
+				return nil, fmt.Errorf("line %d: text before the first \"## \" slide heading", lineNumber)
+			}
+			continue
+		}
+
+		if strings.HasPrefix(line, `\`) && isStructural(strings.TrimLeft(line, `\`)) {
+			line = line[1:]
+		}
+		body = append(body, line)
+	}
+
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("error reading notes: %w", err)
+	}
+
+	flush()
+	return entries, nil
+}
+
+// isStructural reports whether a line would be parsed as a section heading or
+// an object ID comment by ParseMarkdown.
+func isStructural(line string) bool {
+	return titleHeading.MatchString(line) || objectIDTag.MatchString(strings.TrimSpace(line))
+}
@@ -0,0 +1,122 @@
+package notes
+
+import (
+	"bytes"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"testing"
+)
+
+func intPtr(i int) *int {
+	return &i
+}
+
+func TestParseMarkdown(t *testing.T) {
+	tests := []struct {
+		name    string
+		input   string
+		want    []SlideNotes
+		wantErr bool
+	}{
+		{
+			name:  "slide headings with titles and object IDs",
+			input: "## Slide 1: Intro\n<!-- object_id: p1 -->\n\nWelcome.\n\n## Slide 2\n\nSecond\nslide\n",
+			want: []SlideNotes{
+				{SlideIndex: intPtr(0), ObjectID: "p1", Title: "Intro", Notes: "Welcome."},
+				{SlideIndex: intPtr(1), Notes: "Second\nslide"},
+			},
+		},
+		{
+			name:  "title heading",
+			input: "## Agenda\n\nThree points.\n",
+			want:  []SlideNotes{{Title: "Agenda", Notes: "Three points."}},
+		},
+		{
+			name:  "empty notes",
+			input: "## Slide 3: Empty\n<!-- object_id: p3 -->\n",
+			want:  []SlideNotes{{SlideIndex: intPtr(2), ObjectID: "p3", Title: "Empty", Notes: ""}},
+		},
+		{
+			name:  "object ID comment after notes text is kept as text",
+			input: "## Slide 1\n\nText\n<!-- object_id: p9 -->\n",
+			want:  []SlideNotes{{SlideIndex: intPtr(0), Notes: "Text\n<!-- object_id: p9 -->"}},
+		},
+		{
+			name:  "escaped heading stays in the section",
+			input: "## Slide 1\n\nBefore\n\\## Not a slide\n\\\\## Two backslashes\nAfter\n",
+			want:  []SlideNotes{{SlideIndex: intPtr(0), Notes: "Before\n## Not a slide\n\\## Two backslashes\nAfter"}},
+		},
+		{
+			name:  "backslash before ordinary text is kept",
+			input: "## Slide 1\n\n\\n is a newline\n",
+			want:  []SlideNotes{{SlideIndex: intPtr(0), Notes: "\\n is a newline"}},
+		},
+		{
+			name:    "text before the first heading",
+			input:   "stray\n## Slide 1\n",
+			wantErr: true,
+		},
+		{
+			name:    "slide numbers start at 1",
+			input:   "## Slide 0\n\nText\n",
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ParseMarkdown(strings.NewReader(tt.input))
+			if tt.wantErr {
+				if err == nil {
+					t.Fatalf("ParseMarkdown succeeded, want error")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("ParseMarkdown: %v", err)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("ParseMarkdown = %s, want %s", describe(got), describe(tt.want))
+			}
+		})
+	}
+}
+
+func TestMarkdownRoundTrip(t *testing.T) {
+	allNotes := []SlideNotes{
+		{SlideIndex: intPtr(0), ObjectID: "p1", Title: "Intro", Notes: "Welcome.\n## Agenda\n<!-- object_id: p2 -->\n\\## escaped"},
+		{SlideIndex: intPtr(1), ObjectID: "p2", Title: "Agenda", Notes: ""},
+		{SlideIndex: intPtr(2), ObjectID: "p3", Notes: "Thanks"},
+	}
+
+	var buf bytes.Buffer
+	if err := WriteMarkdown(&buf, allNotes); err != nil {
+		t.Fatalf("WriteMarkdown: %v", err)
+	}
+	if !strings.HasPrefix(buf.String(), "## Slide 1: Intro\n") {
+		t.Errorf("WriteMarkdown output starts with %q, want the first slide numbered 1", strings.SplitN(buf.String(), "\n", 2)[0])
+	}
+
+	got, err := ParseMarkdown(&buf)
+	if err != nil {
+		t.Fatalf("ParseMarkdown: %v", err)
+	}
+	if !reflect.DeepEqual(got, allNotes) {
+		t.Errorf("round trip = %s, want %s", describe(got), describe(allNotes))
+	}
+}
+
+// describe formats entries with their slide indices for failure messages.
+func describe(entries []SlideNotes) string {
+	var sb strings.Builder
+	for _, entry := range entries {
+		index := "nil"
+		if entry.SlideIndex != nil {
+			index = strconv.Itoa(*entry.SlideIndex)
+		}
+		fmt.Fprintf(&sb, "{%s %s %q %q} ", index, entry.ObjectID, entry.Title, entry.Notes)
+	}
+	return sb.String()
+}
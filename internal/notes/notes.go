@@ -6,6 +6,8 @@ import (
 	"strings"
 
 	"google.golang.org/api/slides/v1"
+
+	"google-slide-manager/internal/text"
 )
 
 // Service wraps Google Slides service for notes operations.
@@ -22,74 +24,204 @@ func NewService(ctx context.Context, slidesService *slides.Service) *Service {
 
 // Get retrieves speaker notes from a slide.
 func (s *Service) Get(ctx context.Context, presentationID string, slideIndex int) (string, error) {
-	presentation, err := s.slidesService.Presentations.Get(presentationID).Do()
+	slide, err := s.slide(presentationID, slideIndex)
 	if err != nil {
-		return "", fmt.Errorf("error getting presentation: %w", err)
+		return "", err
 	}
 
-	if slideIndex >= len(presentation.Slides) {
-		return "", fmt.Errorf("slide index out of range")
-	}
+	_, notesText := speakerNotes(slide)
+	return notesText, nil
+}
 
-	slide := presentation.Slides[slideIndex]
-	notesPage := slide.SlideProperties.NotesPage
+// Set replaces the speaker notes of a slide. An empty content clears them.
+func (s *Service) Set(ctx context.Context, presentationID string, slideIndex int, content string) error {
+	return s.write(presentationID, slideIndex, content, false)
+}
+
+// Append adds content at the end of the speaker notes of a slide, on a new
+// line when the notes are not empty.
+func (s *Service) Append(ctx context.Context, presentationID string, slideIndex int, content string) error {
+	return s.write(presentationID, slideIndex, content, true)
+}
+
+// Clear removes the speaker notes of a slide.
+func (s *Service) Clear(ctx context.Context, presentationID string, slideIndex int) error {
+	return s.write(presentationID, slideIndex, "", false)
+}
 
-	if notesPage == nil {
-		return "", nil
+// SlideNotes holds the speaker notes of a slide. When importing, a slide is
+// matched by ObjectID, then SlideIndex, then Title.
+type SlideNotes struct {
+	SlideIndex *int   `json:"slide_index,omitempty"`
+	ObjectID   string `json:"object_id,omitempty"`
+	Title      string `json:"title,omitempty"`
+	Notes      string `json:"notes"`
+}
+
+// ExtractAll extracts the speaker notes of every slide, in slide order,
+// including slides without notes.
+func (s *Service) ExtractAll(ctx context.Context, presentationID string) ([]SlideNotes, error) {
+	presentation, err := s.slidesService.Presentations.Get(presentationID).Do()
+	if err != nil {
+		return nil, fmt.Errorf("error getting presentation: %w", err)
 	}
 
-	var notesText strings.Builder
-	for _, element := range notesPage.PageElements {
-		if element.Shape != nil && element.Shape.Text != nil {
-			for _, textElement := range element.Shape.Text.TextElements {
-				if textElement.TextRun != nil {
-					notesText.WriteString(textElement.TextRun.Content)
-				}
-			}
-		}
+	allNotes := make([]SlideNotes, 0, len(presentation.Slides))
+	for idx, slide := range presentation.Slides {
+		_, notesText := speakerNotes(slide)
+		allNotes = append(allNotes, SlideNotes{
+			SlideIndex: &idx,
+			ObjectID:   slide.ObjectId,
+			Title:      text.SlideTitle(slide),
+			Notes:      strings.TrimSpace(notesText),
+		})
 	}
 
-	return notesText.String(), nil
+	return allNotes, nil
 }
 
-// Add adds speaker notes to a slide.
-func (s *Service) Add(ctx context.Context, presentationID string, slideIndex int, notesContent string) error {
+// Import replaces the speaker notes of many slides in a single batch and
+// returns the number of slides whose notes changed. Every entry must match
+// exactly one slide and no slide may be matched twice.
+func (s *Service) Import(ctx context.Context, presentationID string, entries []SlideNotes) (int, error) {
 	presentation, err := s.slidesService.Presentations.Get(presentationID).Do()
 	if err != nil {
-		return fmt.Errorf("error getting presentation: %w", err)
+		return 0, fmt.Errorf("error getting presentation: %w", err)
+	}
+
+	matched := map[int]bool{}
+	var requests []*slides.Request
+	changed := 0
+	for i, entry := range entries {
+		idx, err := matchSlide(presentation.Slides, entry)
+		if err != nil {
+			return 0, fmt.Errorf("notes entry %d: %w", i+1, err)
+		}
+		if matched[idx] {
+			return 0, fmt.Errorf("notes entry %d: slide %d is matched by several entries", i+1, idx)
+		}
+		matched[idx] = true
+
+		objectID, current := speakerNotes(presentation.Slides[idx])
+		if objectID == "" {
+			return 0, fmt.Errorf("notes entry %d: slide %d has no speaker notes shape", i+1, idx)
+		}
+
+		content := strings.TrimSpace(entry.Notes)
+		if strings.TrimSpace(current) == content {
+			continue
+		}
+		changed++
+
+		if current != "" {
+			requests = append(requests, &slides.Request{
+				DeleteText: &slides.DeleteTextRequest{
+					ObjectId:  objectID,
+					TextRange: &slides.Range{Type: "ALL"},
+				},
+			})
+		}
+		if content != "" {
+			requests = append(requests, &slides.Request{
+				InsertText: &slides.InsertTextRequest{
+					ObjectId:       objectID,
+					Text:           content,
+					InsertionIndex: 0,
+				},
+			})
+		}
 	}
 
-	if slideIndex >= len(presentation.Slides) {
-		return fmt.Errorf("slide index out of range")
+	if len(requests) == 0 {
+		return 0, nil
 	}
 
-	slide := presentation.Slides[slideIndex]
-	notesPage := slide.SlideProperties.NotesPage
+	_, err = s.slidesService.Presentations.BatchUpdate(presentationID, &slides.BatchUpdatePresentationRequest{
+		Requests: requests,
+	}).Do()
 
-	if notesPage == nil || len(notesPage.PageElements) == 0 {
-		return fmt.Errorf("notes page not available")
+	if err != nil {
+		return 0, fmt.Errorf("error importing notes: %w", err)
 	}
 
-	var notesShapeID string
-	for _, element := range notesPage.PageElements {
-		if element.Shape != nil {
-			notesShapeID = element.ObjectId
-			break
+	return changed, nil
+}
+
+// matchSlide finds the index of the slide an entry refers to.
+func matchSlide(slideList []*slides.Page, entry SlideNotes) (int, error) {
+	switch {
+	case entry.ObjectID != "":
+		for idx, slide := range slideList {
+			if slide.ObjectId == entry.ObjectID {
+				return idx, nil
+			}
+		}
+		return 0, fmt.Errorf("no slide with object ID %s", entry.ObjectID)
+	case entry.SlideIndex != nil:
+		if *entry.SlideIndex < 0 || *entry.SlideIndex >= len(slideList) {
+			return 0, fmt.Errorf("slide index %d out of range", *entry.SlideIndex)
+		}
+		return *entry.SlideIndex, nil
+	case entry.Title != "":
+		found := -1
+		for idx, slide := range slideList {
+			if strings.EqualFold(text.SlideTitle(slide), strings.TrimSpace(entry.Title)) {
+				if found >= 0 {
+					return 0, fmt.Errorf("several slides are titled %q", entry.Title)
+				}
+				found = idx
+			}
 		}
+		if found < 0 {
+			return 0, fmt.Errorf("no slide titled %q", entry.Title)
+		}
+		return found, nil
+	default:
+		return 0, fmt.Errorf("no object ID, slide index or title to match")
+	}
+}
+
+// write replaces or appends to the speaker notes of a slide.
+func (s *Service) write(presentationID string, slideIndex int, content string, appendText bool) error {
+	slide, err := s.slide(presentationID, slideIndex)
+	if err != nil {
+		return err
 	}
 
-	if notesShapeID == "" {
-		return fmt.Errorf("notes shape not found")
+	objectID, current := speakerNotes(slide)
+	if objectID == "" {
+		return fmt.Errorf("slide %d has no speaker notes shape", slideIndex)
+	}
+
+	var requests []*slides.Request
+	var index int64
+	if appendText {
+		index = text.UTF16Len(current)
+		if current != "" && content != "" {
+			content = "\n" + content
+		}
+	} else if current != "" {
+		// Deleting the text of an empty notes shape is rejected by the API.
+		requests = append(requests, &slides.Request{
+			DeleteText: &slides.DeleteTextRequest{
+				ObjectId:  objectID,
+				TextRange: &slides.Range{Type: "ALL"},
+			},
+		})
 	}
 
-	requests := []*slides.Request{
-		{
+	if content != "" {
+		requests = append(requests, &slides.Request{
 			InsertText: &slides.InsertTextRequest{
-				ObjectId:       notesShapeID,
-				Text:           notesContent,
-				InsertionIndex: 0,
+				ObjectId:       objectID,
+				Text:           content,
+				InsertionIndex: index,
 			},
-		},
+		})
+	}
+
+	if len(requests) == 0 {
+		return nil
 	}
 
 	_, err = s.slidesService.Presentations.BatchUpdate(presentationID, &slides.BatchUpdatePresentationRequest{
@@ -97,42 +229,47 @@ func (s *Service) Add(ctx context.Context, presentationID string, slideIndex int
 	}).Do()
 
 	if err != nil {
-		return fmt.Errorf("error adding notes: %w", err)
+		return fmt.Errorf("error updating notes: %w", err)
 	}
 
 	return nil
 }
 
-// ExtractAll extracts all speaker notes from a presentation.
-func (s *Service) ExtractAll(ctx context.Context, presentationID string) (map[string]string, error) {
+// slide fetches the presentation and returns a slide by index.
+func (s *Service) slide(presentationID string, slideIndex int) (*slides.Page, error) {
 	presentation, err := s.slidesService.Presentations.Get(presentationID).Do()
 	if err != nil {
 		return nil, fmt.Errorf("error getting presentation: %w", err)
 	}
 
-	allNotes := make(map[string]string)
+	if slideIndex < 0 || slideIndex >= len(presentation.Slides) {
+		return nil, fmt.Errorf("slide index out of range")
+	}
 
-	for idx, slide := range presentation.Slides {
-		notesPage := slide.SlideProperties.NotesPage
-		if notesPage == nil {
-			continue
-		}
+	return presentation.Slides[slideIndex], nil
+}
 
-		var notesText strings.Builder
-		for _, element := range notesPage.PageElements {
-			if element.Shape != nil && element.Shape.Text != nil {
-				for _, textElement := range element.Shape.Text.TextElements {
-					if textElement.TextRun != nil {
-						notesText.WriteString(textElement.TextRun.Content)
-					}
-				}
-			}
-		}
+// speakerNotes returns the object ID of the speaker notes shape of a slide and
+// its text without the trailing newline. The shape may not exist on the notes
+// page yet: inserting text into its object ID creates it.
+func speakerNotes(slide *slides.Page) (string, string) {
+	if slide.SlideProperties == nil || slide.SlideProperties.NotesPage == nil {
+		return "", ""
+	}
+
+	notesPage := slide.SlideProperties.NotesPage
+	if notesPage.NotesProperties == nil {
+		return "", ""
+	}
 
-		if notesText.Len() > 0 {
-			allNotes[fmt.Sprintf("slide_%d", idx)] = strings.TrimSpace(notesText.String())
+	objectID := notesPage.NotesProperties.SpeakerNotesObjectId
+	for _, element := range notesPage.PageElements {
+		if element.ObjectId != objectID || element.Shape == nil || element.Shape.Text == nil {
+			continue
 		}
+
+		return objectID, strings.TrimSuffix(text.PlainText(element.Shape.Text), "\n")
 	}
 
-	return allNotes, nil
+	return objectID, ""
 }
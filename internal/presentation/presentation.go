@@ -3,11 +3,23 @@ package presentation
 import (
 	"context"
 	"fmt"
+	"strings"
 
 	"google.golang.org/api/drive/v3"
 	"google.golang.org/api/slides/v1"
 )
 
+const (
+	presentationMimeType = "application/vnd.google-apps.presentation"
+	folderMimeType       = "application/vnd.google-apps.folder"
+)
+
+// File describes a Google Slides file stored in Drive.
+type File struct {
+	ID   string `json:"id"`
+	Name string `json:"name"`
+}
+
 // Service wraps Google Slides and Drive services for presentation operations.
 type Service struct {
 	slidesService *slides.Service
@@ -52,3 +64,54 @@ func (s *Service) Get(ctx context.Context, presentationID string) (*slides.Prese
 	}
 	return presentation, nil
 }
+
+// ListInFolder lists the Google Slides files in a Drive folder. When recursive
+// is true, subfolders are walked as well.
+func (s *Service) ListInFolder(ctx context.Context, folderID string, recursive bool) ([]File, error) {
+	var files []File
+	visited := map[string]bool{}
+	queue := []string{folderID}
+
+	for len(queue) > 0 {
+		current := queue[0]
+		queue = queue[1:]
+		if visited[current] {
+			continue
+		}
+		visited[current] = true
+
+		mimeFilter := fmt.Sprintf("mimeType='%s'", presentationMimeType)
+		if recursive {
+			mimeFilter = fmt.Sprintf("(%s or mimeType='%s')", mimeFilter, folderMimeType)
+		}
+		query := fmt.Sprintf("'%s' in parents and trashed=false and %s", escapeQuery(current), mimeFilter)
+
+		err := s.driveService.Files.List().
+			Q(query).
+			Fields("nextPageToken, files(id, name, mimeType)").
+			OrderBy("name").
+			PageSize(1000).
+			SupportsAllDrives(true).
+			IncludeItemsFromAllDrives(true).
+			Pages(ctx, func(list *drive.FileList) error {
+				for _, f := range list.Files {
+					if f.MimeType == folderMimeType {
+						queue = append(queue, f.Id)
+						continue
+					}
+					files = append(files, File{ID: f.Id, Name: f.Name})
+				}
+				return nil
+			})
+		if err != nil {
+			return nil, fmt.Errorf("error listing folder %s: %w", current, err)
+		}
+	}
+
+	return files, nil
+}
+
+// escapeQuery escapes a value for use inside a quoted Drive query string.
+func escapeQuery(value string) string {
+	return strings.NewReplacer(`\`, `\\`, `'`, `\'`).Replace(value)
+}
@@ -3,11 +3,58 @@ package shape
 import (
 	"context"
 	"fmt"
+	"slices"
+	"strings"
 	"time"
 
 	"google.golang.org/api/slides/v1"
+
+	"google-slide-manager/internal/color"
+	"google-slide-manager/internal/geometry"
 )
 
+// ShapeTypes lists the shape types accepted by CreateShape.
+var ShapeTypes = []string{
+	"TEXT_BOX", "RECTANGLE", "ROUND_RECTANGLE", "ELLIPSE", "ARC", "BENT_ARROW",
+	"BENT_UP_ARROW", "BEVEL", "BLOCK_ARC", "BRACE_PAIR", "BRACKET_PAIR", "CAN", "CHEVRON",
+	"CHORD", "CLOUD", "CORNER", "CUBE", "CURVED_DOWN_ARROW", "CURVED_LEFT_ARROW",
+	"CURVED_RIGHT_ARROW", "CURVED_UP_ARROW", "DECAGON", "DIAGONAL_STRIPE", "DIAMOND",
+	"DODECAGON", "DONUT", "DOUBLE_WAVE", "DOWN_ARROW", "DOWN_ARROW_CALLOUT",
+	"FOLDED_CORNER", "FRAME", "HALF_FRAME", "HEART", "HEPTAGON", "HEXAGON", "HOME_PLATE",
+	"HORIZONTAL_SCROLL", "IRREGULAR_SEAL_1", "IRREGULAR_SEAL_2", "LEFT_ARROW",
+	"LEFT_ARROW_CALLOUT", "LEFT_BRACE", "LEFT_BRACKET", "LEFT_RIGHT_ARROW",
+	"LEFT_RIGHT_ARROW_CALLOUT", "LEFT_RIGHT_UP_ARROW", "LEFT_UP_ARROW", "LIGHTNING_BOLT",
+	"MATH_DIVIDE", "MATH_EQUAL", "MATH_MINUS", "MATH_MULTIPLY", "MATH_NOT_EQUAL",
+	"MATH_PLUS", "MOON", "NO_SMOKING", "NOTCHED_RIGHT_ARROW", "OCTAGON", "PARALLELOGRAM",
+	"PENTAGON", "PIE", "PLAQUE", "PLUS", "QUAD_ARROW", "QUAD_ARROW_CALLOUT", "RIBBON",
+	"RIBBON_2", "RIGHT_ARROW", "RIGHT_ARROW_CALLOUT", "RIGHT_BRACE", "RIGHT_BRACKET",
+	"ROUND_1_RECTANGLE", "ROUND_2_DIAGONAL_RECTANGLE", "ROUND_2_SAME_RECTANGLE",
+	"RIGHT_TRIANGLE", "SMILEY_FACE", "SNIP_1_RECTANGLE", "SNIP_2_DIAGONAL_RECTANGLE",
+	"SNIP_2_SAME_RECTANGLE", "SNIP_ROUND_RECTANGLE", "STAR_10", "STAR_12", "STAR_16",
+	"STAR_24", "STAR_32", "STAR_4", "STAR_5", "STAR_6", "STAR_7", "STAR_8",
+	"STRIPED_RIGHT_ARROW", "SUN", "TRAPEZOID", "TRIANGLE", "UP_ARROW", "UP_ARROW_CALLOUT",
+	"UP_DOWN_ARROW", "UTURN_ARROW", "VERTICAL_SCROLL", "WAVE", "WEDGE_ELLIPSE_CALLOUT",
+	"WEDGE_RECTANGLE_CALLOUT", "WEDGE_ROUND_RECTANGLE_CALLOUT",
+	"FLOW_CHART_ALTERNATE_PROCESS", "FLOW_CHART_COLLATE", "FLOW_CHART_CONNECTOR",
+	"FLOW_CHART_DECISION", "FLOW_CHART_DELAY", "FLOW_CHART_DISPLAY", "FLOW_CHART_DOCUMENT",
+	"FLOW_CHART_EXTRACT", "FLOW_CHART_INPUT_OUTPUT", "FLOW_CHART_INTERNAL_STORAGE",
+	"FLOW_CHART_MAGNETIC_DISK", "FLOW_CHART_MAGNETIC_DRUM", "FLOW_CHART_MAGNETIC_TAPE",
+	"FLOW_CHART_MANUAL_INPUT", "FLOW_CHART_MANUAL_OPERATION", "FLOW_CHART_MERGE",
+	"FLOW_CHART_MULTIDOCUMENT", "FLOW_CHART_OFFLINE_STORAGE",
+	"FLOW_CHART_OFFPAGE_CONNECTOR", "FLOW_CHART_ONLINE_STORAGE", "FLOW_CHART_OR",
+	"FLOW_CHART_PREDEFINED_PROCESS", "FLOW_CHART_PREPARATION", "FLOW_CHART_PROCESS",
+	"FLOW_CHART_PUNCHED_CARD", "FLOW_CHART_PUNCHED_TAPE", "FLOW_CHART_SORT",
+	"FLOW_CHART_SUMMING_JUNCTION", "FLOW_CHART_TERMINATOR", "ARROW_EAST",
+	"ARROW_NORTH_EAST", "ARROW_NORTH", "SPEECH", "STARBURST", "TEARDROP", "ELLIPSE_RIBBON",
+	"ELLIPSE_RIBBON_2", "CLOUD_CALLOUT",
+}
+
+// DashStyles lists the outline dash styles.
+var DashStyles = []string{"SOLID", "DOT", "DASH", "DASH_DOT", "LONG_DASH", "LONG_DASH_DOT"}
+
+// ContentAlignments lists the vertical alignments of text inside a shape.
+var ContentAlignments = []string{"TOP", "MIDDLE", "BOTTOM"}
+
 // Service wraps Google Slides service for shape operations.
 type Service struct {
 	slidesService *slides.Service
@@ -25,43 +72,79 @@ func generateObjectID(prefix string) string {
 	return fmt.Sprintf("%s_%d", prefix, time.Now().UnixNano())
 }
 
-// Add adds a shape to a slide.
-func (s *Service) Add(ctx context.Context, presentationID string, slideIndex int, shapeType string) (string, error) {
+// Options describes the geometry, styling and initial text of a new shape.
+// Empty strings and nil pointers keep the API defaults. Shadows are read-only
+// in the Slides API and therefore cannot be configured.
+type Options struct {
+	Box           geometry.Box
+	Rotation      float64
+	FillColor     string
+	FillAlpha     *float64
+	OutlineColor  string
+	OutlineWeight float64
+	DashStyle     string
+	Text          string
+	VerticalAlign string
+}
+
+// Add adds a shape to a slide. The shape is created, styled and filled with
+// its initial text in a single batch.
+func (s *Service) Add(ctx context.Context, presentationID string, slideIndex int, shapeType string, opts Options) (string, error) {
+	shapeType, err := ValidateShapeType(shapeType)
+	if err != nil {
+		return "", err
+	}
+
+	properties, fields, err := opts.shapeProperties()
+	if err != nil {
+		return "", err
+	}
+
 	presentation, err := s.slidesService.Presentations.Get(presentationID).Do()
 	if err != nil {
 		return "", fmt.Errorf("error getting presentation: %w", err)
 	}
 
-	if slideIndex >= len(presentation.Slides) {
+	if slideIndex < 0 || slideIndex >= len(presentation.Slides) {
 		return "", fmt.Errorf("slide index out of range")
 	}
 
 	slideID := presentation.Slides[slideIndex].ObjectId
 	shapeID := generateObjectID("shape")
 
+	elementProperties := geometry.ElementProperties(slideID, opts.Box)
+	elementProperties.Transform = geometry.Transform(opts.Box, opts.Rotation)
+
 	requests := []*slides.Request{
 		{
 			CreateShape: &slides.CreateShapeRequest{
-				ObjectId:  shapeID,
-				ShapeType: shapeType,
-				ElementProperties: &slides.PageElementProperties{
-					PageObjectId: slideID,
-					Size: &slides.Size{
-						Width:  &slides.Dimension{Magnitude: 100, Unit: "PT"},
-						Height: &slides.Dimension{Magnitude: 100, Unit: "PT"},
-					},
-					Transform: &slides.AffineTransform{
-						ScaleX:     1.0,
-						ScaleY:     1.0,
-						TranslateX: 100.0,
-						TranslateY: 100.0,
-						Unit:       "PT",
-					},
-				},
+				ObjectId:          shapeID,
+				ShapeType:         shapeType,
+				ElementProperties: elementProperties,
 			},
 		},
 	}
 
+	if len(fields) > 0 {
+		requests = append(requests, &slides.Request{
+			UpdateShapeProperties: &slides.UpdateShapePropertiesRequest{
+				ObjectId:        shapeID,
+				ShapeProperties: properties,
+				Fields:          strings.Join(fields, ","),
+			},
+		})
+	}
+
+	if opts.Text != "" {
+		requests = append(requests, &slides.Request{
+			InsertText: &slides.InsertTextRequest{
+				ObjectId:       shapeID,
+				Text:           opts.Text,
+				InsertionIndex: 0,
+			},
+		})
+	}
+
 	_, err = s.slidesService.Presentations.BatchUpdate(presentationID, &slides.BatchUpdatePresentationRequest{
 		Requests: requests,
 	}).Do()
@@ -72,3 +155,205 @@ func (s *Service) Add(ctx context.Context, presentationID string, slideIndex int
 
 	return shapeID, nil
 }
+
+// shapeProperties converts the styling options to ShapeProperties and the
+// matching field mask.
+func (o Options) shapeProperties() (*slides.ShapeProperties, []string, error) {
+	properties := &slides.ShapeProperties{}
+	var fields []string
+
+	if o.FillColor != "" || o.FillAlpha != nil {
+		fill := &slides.SolidFill{}
+		alpha := o.FillAlpha
+		if o.FillColor != "" {
+			c, colorAlpha, err := color.ParseAlpha(o.FillColor)
+			if err != nil {
+				return nil, nil, fmt.Errorf("invalid fill color: %w", err)
+			}
+			fill.Color = c
+			fields = append(fields, "shapeBackgroundFill.solidFill.color")
+			if alpha == nil && colorAlpha != 1 {
+				alpha = &colorAlpha
+			}
+		}
+		if alpha != nil {
+			if *alpha < 0 || *alpha > 1 {
+				return nil, nil, fmt.Errorf("invalid fill alpha %g: must be between 0 and 1", *alpha)
+			}
+			fill.Alpha = *alpha
+			fill.ForceSendFields = []string{"Alpha"}
+			fields = append(fields, "shapeBackgroundFill.solidFill.alpha")
+		}
+		properties.ShapeBackgroundFill = &slides.ShapeBackgroundFill{SolidFill: fill}
+	}
+
+	if o.OutlineColor != "" || o.OutlineWeight != 0 || o.DashStyle != "" {
+		outline := &slides.Outline{}
+		if o.OutlineColor != "" {
+			c, err := color.Parse(o.OutlineColor)
+			if err != nil {
+				return nil, nil, fmt.Errorf("invalid outline color: %w", err)
+			}
+			outline.OutlineFill = &slides.OutlineFill{SolidFill: &slides.SolidFill{Color: c}}
+			fields = append(fields, "outline.outlineFill.solidFill.color")
+		}
+		if o.OutlineWeight < 0 {
+			return nil, nil, fmt.Errorf("invalid outline weight: %g", o.OutlineWeight)
+		}
+		if o.OutlineWeight > 0 {
+			outline.Weight = &slides.Dimension{Magnitude: o.OutlineWeight, Unit: "PT"}
+			fields = append(fields, "outline.weight")
+		}
+		if o.DashStyle != "" {
+			dashStyle := strings.ToUpper(o.DashStyle)
+			if !slices.Contains(DashStyles, dashStyle) {
+				return nil, nil, fmt.Errorf("invalid dash style %q (expected one of %s)", o.DashStyle, strings.Join(DashStyles, ", "))
+			}
+			outline.DashStyle = dashStyle
+			fields = append(fields, "outline.dashStyle")
+		}
+		properties.Outline = outline
+	}
+
+	if o.VerticalAlign != "" {
+		alignment := strings.ToUpper(o.VerticalAlign)
+		if !slices.Contains(ContentAlignments, alignment) {
+			return nil, nil, fmt.Errorf("invalid vertical alignment %q (expected one of %s)", o.VerticalAlign, strings.Join(ContentAlignments, ", "))
+		}
+		properties.ContentAlignment = alignment
+		fields = append(fields, "contentAlignment")
+	}
+
+	return properties, fields, nil
+}
+
+// ValidateShapeType normalizes a shape type and checks it against the API
+// enum, suggesting close matches when it is unknown.
+func ValidateShapeType(shapeType string) (string, error) {
+	normalized := strings.ToUpper(strings.NewReplacer("-", "_", " ", "_").Replace(strings.TrimSpace(shapeType)))
+	if slices.Contains(ShapeTypes, normalized) {
+		return normalized, nil
+	}
+
+	suggestions := suggest(normalized, ShapeTypes)
+	if len(suggestions) == 0 {
+		return "", fmt.Errorf("unknown shape type %q", shapeType)
+	}
+
+	return "", fmt.Errorf("unknown shape type %q (did you mean %s?)", shapeType, strings.Join(suggestions, ", "))
+}
+
+// suggest returns up to three candidates close to value, by edit distance
+// first and then by substring match.
+func suggest(value string, candidates []string) []string {
+	type scored struct {
+		name     string
+		distance int
+	}
+
+	maxDistance := len(value) / 3
+	if maxDistance < 2 {
+		maxDistance = 2
+	}
+
+	var matches []scored
+	for _, candidate := range candidates {
+		d := levenshtein(value, candidate)
+		switch {
+		case d <= maxDistance:
+			matches = append(matches, scored{candidate, d})
+		case value != "" && strings.Contains(candidate, value):
+			matches = append(matches, scored{candidate, maxDistance + 1})
+		}
+	}
+
+	slices.SortStableFunc(matches, func(a, b scored) int { return a.distance - b.distance })
+
+	var names []string
+	for i := 0; i < len(matches) && i < 3; i++ {
+		names = append(names, matches[i].name)
+	}
+	return names
+}
+
+// levenshtein computes the edit distance between two strings.
+func levenshtein(a, b string) int {
+	prev := make([]int, len(b)+1)
+	curr := make([]int, len(b)+1)
+	for j := range prev {
+		prev[j] = j
+	}
+
+	for i := 1; i <= len(a); i++ {
+		curr[0] = i
+		for j := 1; j <= len(b); j++ {
+			cost := 1
+			if a[i-1] == b[j-1] {
+				cost = 0
+			}
+			curr[j] = min(prev[j]+1, curr[j-1]+1, prev[j-1]+cost)
+		}
+		prev, curr = curr, prev
+	}
+
+	return prev[len(b)]
+}
+
+// AddTextBox adds a text box at the given position and size, filled with text,
+// in a single batch. When noAutofit is set, autofit is turned off on the new box;
+// the Slides API rejects every other autofit type in updates.
+func (s *Service) AddTextBox(ctx context.Context, presentationID string, slideIndex int, box geometry.Box, text string, noAutofit bool) (string, error) {
+	presentation, err := s.slidesService.Presentations.Get(presentationID).Do()
+	if err != nil {
+		return "", fmt.Errorf("error getting presentation: %w", err)
+	}
+
+	if slideIndex < 0 || slideIndex >= len(presentation.Slides) {
+		return "", fmt.Errorf("slide index out of range")
+	}
+
+	slideID := presentation.Slides[slideIndex].ObjectId
+	textBoxID := generateObjectID("textbox")
+
+	requests := []*slides.Request{
+		{
+			CreateShape: &slides.CreateShapeRequest{
+				ObjectId:          textBoxID,
+				ShapeType:         "TEXT_BOX",
+				ElementProperties: geometry.ElementProperties(slideID, box),
+			},
+		},
+	}
+
+	if text != "" {
+		requests = append(requests, &slides.Request{
+			InsertText: &slides.InsertTextRequest{
+				ObjectId:       textBoxID,
+				Text:           text,
+				InsertionIndex: 0,
+			},
+		})
+	}
+
+	if noAutofit {
+		requests = append(requests, &slides.Request{
+			UpdateShapeProperties: &slides.UpdateShapePropertiesRequest{
+				ObjectId: textBoxID,
+				ShapeProperties: &slides.ShapeProperties{
+					Autofit: &slides.Autofit{AutofitType: "NONE"},
+				},
+				Fields: "autofit.autofitType",
+			},
+		})
+	}
+
+	_, err = s.slidesService.Presentations.BatchUpdate(presentationID, &slides.BatchUpdatePresentationRequest{
+		Requests: requests,
+	}).Do()
+
+	if err != nil {
+		return "", fmt.Errorf("error adding text box: %w", err)
+	}
+
+	return textBoxID, nil
+}
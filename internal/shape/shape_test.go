@@ -0,0 +1,149 @@
+package shape
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"google.golang.org/api/option"
+	"google.golang.org/api/slides/v1"
+
+	"google-slide-manager/internal/geometry"
+)
+
+func TestValidateShapeType(t *testing.T) {
+	tests := []struct {
+		input      string
+		want       string
+		suggestion string
+	}{
+		{input: "RECTANGLE", want: "RECTANGLE"},
+		{input: "round-rectangle", want: "ROUND_RECTANGLE"},
+		{input: " round rectangle ", want: "ROUND_RECTANGLE"},
+		{input: "RECTANGEL", suggestion: "RECTANGLE"},
+		{input: "elipse", suggestion: "ELLIPSE"},
+		{input: "STAR", suggestion: "STAR_5"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			got, err := ValidateShapeType(tt.input)
+			if tt.suggestion == "" {
+				if err != nil {
+					t.Fatalf("ValidateShapeType(%q): %v", tt.input, err)
+				}
+				if got != tt.want {
+					t.Errorf("ValidateShapeType(%q) = %q, want %q", tt.input, got, tt.want)
+				}
+				return
+			}
+
+			if err == nil {
+				t.Fatalf("ValidateShapeType(%q) = %q, want error", tt.input, got)
+			}
+			if !strings.Contains(err.Error(), tt.suggestion) {
+				t.Errorf("error %q does not suggest %s", err, tt.suggestion)
+			}
+		})
+	}
+}
+
+func TestValidateShapeTypeWithoutSuggestion(t *testing.T) {
+	_, err := ValidateShapeType("QWERTYUIOPASDFGHJKL")
+	if err == nil {
+		t.Fatal("ValidateShapeType succeeded, want error")
+	}
+	if strings.Contains(err.Error(), "did you mean") {
+		t.Errorf("error %q has suggestions, want none", err)
+	}
+}
+
+func TestSuggest(t *testing.T) {
+	candidates := []string{"ARROW", "LEFT_ARROW", "RIGHT_ARROW", "UP_ARROW", "CLOUD"}
+
+	tests := []struct {
+		value string
+		want  []string
+	}{
+		{value: "ARROW", want: []string{"ARROW", "LEFT_ARROW", "RIGHT_ARROW"}},
+		{value: "CLUOD", want: []string{"CLOUD"}},
+		{value: "HEXAGON", want: nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.value, func(t *testing.T) {
+			got := suggest(tt.value, candidates)
+			if strings.Join(got, ",") != strings.Join(tt.want, ",") {
+				t.Errorf("suggest(%q) = %v, want %v", tt.value, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestLevenshtein(t *testing.T) {
+	tests := []struct {
+		a, b string
+		want int
+	}{
+		{"", "", 0},
+		{"abc", "", 3},
+		{"kitten", "sitting", 3},
+		{"CLOUD", "CLUOD", 2},
+	}
+
+	for _, tt := range tests {
+		if got := levenshtein(tt.a, tt.b); got != tt.want {
+			t.Errorf("levenshtein(%q, %q) = %d, want %d", tt.a, tt.b, got, tt.want)
+		}
+	}
+}
+
+// testService returns a Service backed by a server that answers every request
+// with a presentation of the given number of slides and fails the test on any
+// write.
+func testService(t *testing.T, slideCount int) *Service {
+	t.Helper()
+
+	presentation := &slides.Presentation{PresentationId: "p"}
+	for i := 0; i < slideCount; i++ {
+		presentation.Slides = append(presentation.Slides, &slides.Page{ObjectId: fmt.Sprintf("slide%d", i)})
+	}
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet {
+			t.Errorf("unexpected %s %s", r.Method, r.URL.Path)
+			http.Error(w, "unexpected request", http.StatusBadRequest)
+			return
+		}
+		json.NewEncoder(w).Encode(presentation)
+	}))
+	t.Cleanup(server.Close)
+
+	ctx := context.Background()
+	slidesService, err := slides.NewService(ctx,
+		option.WithEndpoint(server.URL),
+		option.WithHTTPClient(server.Client()))
+	if err != nil {
+		t.Fatalf("slides.NewService: %v", err)
+	}
+	return NewService(ctx, slidesService)
+}
+
+func TestSlideIndexOutOfRange(t *testing.T) {
+	service := testService(t, 2)
+	ctx := context.Background()
+	box := geometry.Box{Width: 100, Height: 50}
+
+	for _, slideIndex := range []int{-1, 2} {
+		if _, err := service.Add(ctx, "p", slideIndex, "RECTANGLE", Options{Box: box}); err == nil {
+			t.Errorf("Add(slide %d) succeeded, want error", slideIndex)
+		}
+		if _, err := service.AddTextBox(ctx, "p", slideIndex, box, "text", false); err == nil {
+			t.Errorf("AddTextBox(slide %d) succeeded, want error", slideIndex)
+		}
+	}
+}
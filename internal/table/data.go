@@ -0,0 +1,173 @@
+package table
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"unicode/utf8"
+)
+
+// ReadCSV reads a CSV file, or standard input when path is "-". Records may
+// have different lengths.
+func ReadCSV(path string) ([][]string, error) {
+	var r io.Reader = os.Stdin
+	if path != "-" {
+		f, err := os.Open(path)
+		if err != nil {
+			return nil, fmt.Errorf("error opening CSV file: %w", err)
+		}
+		defer f.Close()
+		r = f
+	}
+
+	reader := csv.NewReader(r)
+	reader.FieldsPerRecord = -1
+
+	records, err := reader.ReadAll()
+	if err != nil {
+		return nil, fmt.Errorf("error reading CSV: %w", err)
+	}
+
+	if len(records) == 0 {
+		return nil, fmt.Errorf("CSV has no rows")
+	}
+
+	return records, nil
+}
+
+// Dimensions returns the number of rows and the length of the longest row.
+func Dimensions(data [][]string) (int64, int64) {
+	var cols int
+	for _, record := range data {
+		cols = max(cols, len(record))
+	}
+	return int64(len(data)), int64(cols)
+}
+
+// fitContent estimates column widths and row heights in PT from cell contents.
+func fitContent(data [][]string, rows int64, cols int64) ([]float64, []float64) {
+	widths := make([]float64, cols)
+	heights := make([]float64, rows)
+	for i := range widths {
+		widths[i] = MinColumnWidth
+	}
+
+	for row := range heights {
+		lines := 1
+		if row < len(data) {
+			for col, value := range data[row] {
+				if int64(col) >= cols {
+					break
+				}
+				cellLines := strings.Split(value, "\n")
+				lines = max(lines, len(cellLines))
+				for _, line := range cellLines {
+					width := float64(utf8.RuneCountInString(line))*defaultFontSize*charWidthRatio + cellPadding
+					widths[col] = max(widths[col], width)
+				}
+			}
+		}
+		heights[row] = float64(lines)*defaultFontSize*lineHeightRatio + cellPadding
+	}
+
+	return widths, heights
+}
+
+// sum adds up values.
+func sum(values []float64) float64 {
+	var total float64
+	for _, v := range values {
+		total += v
+	}
+	return total
+}
+
+// ParseCells parses a block of cell values given either as a JSON array of
+// arrays or as CSV. JSON numbers and booleans are kept as written and null
+// leaves the cell empty.
+func ParseCells(value string) ([][]string, error) {
+	trimmed := strings.TrimSpace(value)
+	if !strings.HasPrefix(trimmed, "[") {
+		reader := csv.NewReader(strings.NewReader(value))
+		reader.FieldsPerRecord = -1
+		records, err := reader.ReadAll()
+		if err != nil {
+			return nil, fmt.Errorf("error parsing cells as CSV: %w", err)
+		}
+		return records, nil
+	}
+
+	decoder := json.NewDecoder(strings.NewReader(trimmed))
+	decoder.UseNumber()
+
+	var rows [][]any
+	if err := decoder.Decode(&rows); err != nil {
+		return nil, fmt.Errorf("error parsing cells as JSON (expected an array of arrays): %w", err)
+	}
+
+	data := make([][]string, len(rows))
+	for i, row := range rows {
+		data[i] = make([]string, len(row))
+		for j, v := range row {
+			switch v := v.(type) {
+			case nil:
+			case string:
+				data[i][j] = v
+			case json.Number, bool:
+				data[i][j] = fmt.Sprint(v)
+			default:
+				return nil, fmt.Errorf("cell [%d][%d] must be a string, number, boolean or null", i, j)
+			}
+		}
+	}
+
+	return data, nil
+}
+
+// WriteCSV writes rows as CSV.
+func WriteCSV(w io.Writer, data [][]string) error {
+	writer := csv.NewWriter(w)
+	if err := writer.WriteAll(data); err != nil {
+		return fmt.Errorf("error writing CSV: %w", err)
+	}
+	return nil
+}
+
+// WriteMarkdown writes rows as a Markdown table whose first row is the header.
+func WriteMarkdown(w io.Writer, data [][]string) error {
+	if len(data) == 0 {
+		return nil
+	}
+
+	escape := strings.NewReplacer("|", `\|`, "\r\n", "<br>", "\n", "<br>")
+	writeRow := func(row []string) error {
+		cells := make([]string, len(row))
+		for i, cell := range row {
+			cells[i] = escape.Replace(cell)
+		}
+		_, err := fmt.Fprintf(w, "| %s |\n", strings.Join(cells, " | "))
+		return err
+	}
+
+	if err := writeRow(data[0]); err != nil {
+		return err
+	}
+
+	separator := make([]string, len(data[0]))
+	for i := range separator {
+		separator[i] = "---"
+	}
+	if err := writeRow(separator); err != nil {
+		return err
+	}
+
+	for _, row := range data[1:] {
+		if err := writeRow(row); err != nil {
+			return err
+		}
+	}
+	return nil
+}
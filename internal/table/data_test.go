@@ -0,0 +1,61 @@
+package table
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestParseCells(t *testing.T) {
+	tests := []struct {
+		name    string
+		value   string
+		want    [][]string
+		wantErr bool
+	}{
+		{name: "csv", value: "a,b\nc,d", want: [][]string{{"a", "b"}, {"c", "d"}}},
+		{name: "csv with ragged rows", value: "a,b,c\nd", want: [][]string{{"a", "b", "c"}, {"d"}}},
+		{name: "csv with quotes", value: `"x, y",z`, want: [][]string{{"x, y", "z"}}},
+		{name: "json strings", value: `[["a","b"],["c"]]`, want: [][]string{{"a", "b"}, {"c"}}},
+		{name: "json numbers kept as written", value: `[[1.50, 2e3, -7]]`, want: [][]string{{"1.50", "2e3", "-7"}}},
+		{name: "json booleans and null", value: ` [[true, null, false]]`, want: [][]string{{"true", "", "false"}}},
+		{name: "json object cell", value: `[[{"a":1}]]`, wantErr: true},
+		{name: "json not an array of arrays", value: `["a","b"]`, wantErr: true},
+		{name: "invalid csv", value: `"unterminated`, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ParseCells(tt.value)
+			if tt.wantErr {
+				if err == nil {
+					t.Fatalf("ParseCells(%q) = %q, want error", tt.value, got)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("ParseCells(%q): %v", tt.value, err)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("ParseCells(%q) = %q, want %q", tt.value, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestDimensions(t *testing.T) {
+	tests := []struct {
+		data       [][]string
+		rows, cols int64
+	}{
+		{data: nil, rows: 0, cols: 0},
+		{data: [][]string{{"a"}}, rows: 1, cols: 1},
+		{data: [][]string{{"a"}, {"b", "c", "d"}, {}}, rows: 3, cols: 3},
+	}
+
+	for _, tt := range tests {
+		rows, cols := Dimensions(tt.data)
+		if rows != tt.rows || cols != tt.cols {
+			t.Errorf("Dimensions(%q) = %dx%d, want %dx%d", tt.data, rows, cols, tt.rows, tt.cols)
+		}
+	}
+}
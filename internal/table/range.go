@@ -0,0 +1,114 @@
+package table
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"google.golang.org/api/slides/v1"
+)
+
+var (
+	a1Pattern       = regexp.MustCompile(`^([A-Za-z]+)([1-9][0-9]*)$`)
+	rowColPattern   = regexp.MustCompile(`^[rR](\d+)(?:-(\d+))?,\s*[cC](\d+)(?:-(\d+))?$`)
+	cellPairPattern = regexp.MustCompile(`^(\d+)\s*,\s*(\d+)$`)
+)
+
+// ParseRange parses a range of table cells. Accepted forms are:
+//
+//	A1 or A1:C3         spreadsheet notation (column letters, 1-based rows)
+//	r0-2,c1 or r1,c0-3  0-based row and column indices or inclusive ranges
+//	0,1 or 0,0:2,3      0-based row,col cells as corners
+//
+// Corners may be given in any order.
+func ParseRange(value string) (*slides.TableRange, error) {
+	value = strings.TrimSpace(value)
+
+	if m := rowColPattern.FindStringSubmatch(value); m != nil {
+		rowStart, rowEnd := atoi(m[1]), atoi(m[1])
+		if m[2] != "" {
+			rowEnd = atoi(m[2])
+		}
+		colStart, colEnd := atoi(m[3]), atoi(m[3])
+		if m[4] != "" {
+			colEnd = atoi(m[4])
+		}
+		return newRange(rowStart, colStart, rowEnd, colEnd), nil
+	}
+
+	startStr, endStr, isRange := strings.Cut(value, ":")
+	if !isRange {
+		endStr = startStr
+	}
+
+	startRow, startCol, err := parseCorner(startStr)
+	if err != nil {
+		return nil, fmt.Errorf("invalid cell range %q: %w", value, err)
+	}
+
+	endRow, endCol, err := parseCorner(endStr)
+	if err != nil {
+		return nil, fmt.Errorf("invalid cell range %q: %w", value, err)
+	}
+
+	return newRange(startRow, startCol, endRow, endCol), nil
+}
+
+// parseCorner parses a single cell in A1 or row,col notation to 0-based indices.
+func parseCorner(value string) (int64, int64, error) {
+	value = strings.TrimSpace(value)
+
+	if m := cellPairPattern.FindStringSubmatch(value); m != nil {
+		return atoi(m[1]), atoi(m[2]), nil
+	}
+
+	m := a1Pattern.FindStringSubmatch(value)
+	if m == nil {
+		return 0, 0, fmt.Errorf("expected A1, r0-2,c1 or row,col notation")
+	}
+
+	var col int64
+	for _, letter := range strings.ToUpper(m[1]) {
+		col = col*26 + int64(letter-'A'+1)
+	}
+
+	return atoi(m[2]) - 1, col - 1, nil
+}
+
+// newRange builds a table range from two inclusive corners.
+func newRange(row1, col1, row2, col2 int64) *slides.TableRange {
+	return &slides.TableRange{
+		Location: &slides.TableCellLocation{
+			RowIndex:    min(row1, row2),
+			ColumnIndex: min(col1, col2),
+		},
+		RowSpan:    max(row1, row2) - min(row1, row2) + 1,
+		ColumnSpan: max(col1, col2) - min(col1, col2) + 1,
+	}
+}
+
+// checkRange verifies that a range lies within a table.
+func checkRange(table *slides.Table, tableRange *slides.TableRange) error {
+	row, col := tableRange.Location.RowIndex, tableRange.Location.ColumnIndex
+	if row+tableRange.RowSpan > table.Rows || col+tableRange.ColumnSpan > table.Columns {
+		return fmt.Errorf("cell range rows %d-%d, columns %d-%d is outside the %dx%d table",
+			row, row+tableRange.RowSpan-1, col, col+tableRange.ColumnSpan-1, table.Rows, table.Columns)
+	}
+	return nil
+}
+
+// atoi converts a string of digits matched by a pattern.
+func atoi(s string) int64 {
+	n, _ := strconv.ParseInt(s, 10, 64)
+	return n
+}
+
+// CellName returns the spreadsheet name of a cell from 0-based indices, e.g. B3.
+func CellName(row int64, col int64) string {
+	var letters []byte
+	for n := col + 1; n > 0; n = (n - 1) / 26 {
+		letters = append([]byte{byte('A' + (n-1)%26)}, letters...)
+	}
+	return fmt.Sprintf("%s%d", letters, row+1)
+}
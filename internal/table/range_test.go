@@ -0,0 +1,74 @@
+package table
+
+import (
+	"testing"
+)
+
+func TestParseRange(t *testing.T) {
+	tests := []struct {
+		value            string
+		row, col         int64
+		rowSpan, colSpan int64
+		wantErr          bool
+	}{
+		{value: "A1", row: 0, col: 0, rowSpan: 1, colSpan: 1},
+		{value: "b3", row: 2, col: 1, rowSpan: 1, colSpan: 1},
+		{value: "A1:C3", row: 0, col: 0, rowSpan: 3, colSpan: 3},
+		{value: "C3:A1", row: 0, col: 0, rowSpan: 3, colSpan: 3},
+		{value: "AA10", row: 9, col: 26, rowSpan: 1, colSpan: 1},
+		{value: "r0-2,c1", row: 0, col: 1, rowSpan: 3, colSpan: 1},
+		{value: "R1, C0-3", row: 1, col: 0, rowSpan: 1, colSpan: 4},
+		{value: "r2-0,c1", row: 0, col: 1, rowSpan: 3, colSpan: 1},
+		{value: "0,1", row: 0, col: 1, rowSpan: 1, colSpan: 1},
+		{value: "0,0:2,3", row: 0, col: 0, rowSpan: 3, colSpan: 4},
+		{value: " 2, 3 : 1,1 ", row: 1, col: 1, rowSpan: 2, colSpan: 3},
+		{value: "A0", wantErr: true},
+		{value: "1A", wantErr: true},
+		{value: "A1:", wantErr: true},
+		{value: "r1", row: 0, col: 17, rowSpan: 1, colSpan: 1}, // column R, row 1
+		{value: "r1-2", wantErr: true},
+		{value: "", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.value, func(t *testing.T) {
+			got, err := ParseRange(tt.value)
+			if tt.wantErr {
+				if err == nil {
+					t.Fatalf("ParseRange(%q) = %+v, want error", tt.value, got)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("ParseRange(%q): %v", tt.value, err)
+			}
+
+			if got.Location.RowIndex != tt.row || got.Location.ColumnIndex != tt.col ||
+				got.RowSpan != tt.rowSpan || got.ColumnSpan != tt.colSpan {
+				t.Errorf("ParseRange(%q) = row %d col %d span %dx%d, want row %d col %d span %dx%d", tt.value,
+					got.Location.RowIndex, got.Location.ColumnIndex, got.RowSpan, got.ColumnSpan,
+					tt.row, tt.col, tt.rowSpan, tt.colSpan)
+			}
+		})
+	}
+}
+
+func TestCellName(t *testing.T) {
+	tests := []struct {
+		row, col int64
+		want     string
+	}{
+		{0, 0, "A1"},
+		{2, 1, "B3"},
+		{0, 25, "Z1"},
+		{9, 26, "AA10"},
+		{0, 701, "ZZ1"},
+		{0, 702, "AAA1"},
+	}
+
+	for _, tt := range tests {
+		if got := CellName(tt.row, tt.col); got != tt.want {
+			t.Errorf("CellName(%d, %d) = %q, want %q", tt.row, tt.col, got, tt.want)
+		}
+	}
+}
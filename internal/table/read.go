@@ -0,0 +1,125 @@
+package table
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"strings"
+	"text/tabwriter"
+
+	"google.golang.org/api/slides/v1"
+
+	"google-slide-manager/internal/geometry"
+)
+
+// Merged cell policies for Read.
+const (
+	MergedBlank = "blank"
+	MergedFill  = "fill"
+)
+
+// Info describes a table of a presentation.
+type Info struct {
+	SlideIndex int      `json:"slide_index"`
+	ObjectID   string   `json:"object_id"`
+	Rows       int64    `json:"rows"`
+	Columns    int64    `json:"columns"`
+	Headers    []string `json:"headers"`
+}
+
+// Read returns the text of every cell of a table, row by row. Cells covered by
+// a merged cell are left empty with MergedBlank, or repeat the merged cell's
+// text with MergedFill.
+func (s *Service) Read(ctx context.Context, presentationID string, tableID string, merged string) ([][]string, error) {
+	if merged != MergedBlank && merged != MergedFill {
+		return nil, fmt.Errorf("invalid merged cell policy %q (expected blank or fill)", merged)
+	}
+
+	presentation, err := s.slidesService.Presentations.Get(presentationID).Do()
+	if err != nil {
+		return nil, fmt.Errorf("error getting presentation: %w", err)
+	}
+
+	table, err := findTable(presentation, tableID)
+	if err != nil {
+		return nil, err
+	}
+
+	return tableData(table, merged), nil
+}
+
+// List returns every table of a presentation, including tables inside groups,
+// with its first row as headers.
+func (s *Service) List(ctx context.Context, presentationID string) ([]Info, error) {
+	presentation, err := s.slidesService.Presentations.Get(presentationID).Do()
+	if err != nil {
+		return nil, fmt.Errorf("error getting presentation: %w", err)
+	}
+
+	tables := []Info{}
+	for _, placement := range geometry.Walk(presentation) {
+		table := placement.Element.Table
+		if table == nil {
+			continue
+		}
+
+		info := Info{
+			SlideIndex: placement.SlideIndex,
+			ObjectID:   placement.Element.ObjectId,
+			Rows:       table.Rows,
+			Columns:    table.Columns,
+			Headers:    []string{},
+		}
+		if data := tableData(table, MergedFill); len(data) > 0 {
+			info.Headers = data[0]
+		}
+		tables = append(tables, info)
+	}
+
+	return tables, nil
+}
+
+// WriteList writes tables as an aligned text table.
+func WriteList(w io.Writer, tables []Info) error {
+	tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(tw, "SLIDE\tTABLE ID\tSIZE\tHEADERS")
+	for _, info := range tables {
+		fmt.Fprintf(tw, "%d\t%s\t%dx%d\t%s\n", info.SlideIndex, info.ObjectID, info.Rows, info.Columns, strings.Join(info.Headers, " | "))
+	}
+	return tw.Flush()
+}
+
+// tableData extracts the cell texts of a table, applying the merged cell policy.
+func tableData(table *slides.Table, merged string) [][]string {
+	data := make([][]string, len(table.TableRows))
+	for r, row := range table.TableRows {
+		data[r] = make([]string, table.Columns)
+		for c, cell := range row.TableCells {
+			if c < len(data[r]) {
+				data[r][c] = cellText(cell.Text)
+			}
+		}
+	}
+
+	if merged != MergedFill {
+		return data
+	}
+
+	for r, row := range table.TableRows {
+		for c, cell := range row.TableCells {
+			if cell.RowSpan <= 1 && cell.ColumnSpan <= 1 {
+				continue
+			}
+			for dr := int64(0); dr < max(cell.RowSpan, 1); dr++ {
+				for dc := int64(0); dc < max(cell.ColumnSpan, 1); dc++ {
+					rr, cc := r+int(dr), c+int(dc)
+					if rr < len(data) && cc < len(data[rr]) {
+						data[rr][cc] = data[r][c]
+					}
+				}
+			}
+		}
+	}
+
+	return data
+}
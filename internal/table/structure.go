@@ -0,0 +1,177 @@
+package table
+
+import (
+	"context"
+	"fmt"
+
+	"google.golang.org/api/slides/v1"
+)
+
+// MinColumnWidth is the smallest column width in PT accepted by the API.
+const MinColumnWidth = 32.0
+
+// InsertRows inserts count rows above row, or below it when below is true.
+func (s *Service) InsertRows(ctx context.Context, presentationID string, tableID string, row int64, count int64, below bool) error {
+	if count <= 0 {
+		return fmt.Errorf("count must be positive")
+	}
+
+	requests := []*slides.Request{
+		{
+			InsertTableRows: &slides.InsertTableRowsRequest{
+				TableObjectId: tableID,
+				CellLocation:  &slides.TableCellLocation{RowIndex: row},
+				InsertBelow:   below,
+				Number:        count,
+			},
+		},
+	}
+
+	return s.batch(presentationID, requests, "error inserting rows")
+}
+
+// InsertColumns inserts count columns left of col, or right of it when right is true.
+func (s *Service) InsertColumns(ctx context.Context, presentationID string, tableID string, col int64, count int64, right bool) error {
+	if count <= 0 {
+		return fmt.Errorf("count must be positive")
+	}
+
+	requests := []*slides.Request{
+		{
+			InsertTableColumns: &slides.InsertTableColumnsRequest{
+				TableObjectId: tableID,
+				CellLocation:  &slides.TableCellLocation{ColumnIndex: col},
+				InsertRight:   right,
+				Number:        count,
+			},
+		},
+	}
+
+	return s.batch(presentationID, requests, "error inserting columns")
+}
+
+// DeleteRows deletes count rows starting at row.
+func (s *Service) DeleteRows(ctx context.Context, presentationID string, tableID string, row int64, count int64) error {
+	if count <= 0 {
+		return fmt.Errorf("count must be positive")
+	}
+
+	// Delete from the last row so that the indices of the remaining ones do not shift.
+	var requests []*slides.Request
+	for r := row + count - 1; r >= row; r-- {
+		requests = append(requests, &slides.Request{
+			DeleteTableRow: &slides.DeleteTableRowRequest{
+				TableObjectId: tableID,
+				CellLocation:  &slides.TableCellLocation{RowIndex: r},
+			},
+		})
+	}
+
+	return s.batch(presentationID, requests, "error deleting rows")
+}
+
+// DeleteColumns deletes count columns starting at col.
+func (s *Service) DeleteColumns(ctx context.Context, presentationID string, tableID string, col int64, count int64) error {
+	if count <= 0 {
+		return fmt.Errorf("count must be positive")
+	}
+
+	var requests []*slides.Request
+	for c := col + count - 1; c >= col; c-- {
+		requests = append(requests, &slides.Request{
+			DeleteTableColumn: &slides.DeleteTableColumnRequest{
+				TableObjectId: tableID,
+				CellLocation:  &slides.TableCellLocation{ColumnIndex: c},
+			},
+		})
+	}
+
+	return s.batch(presentationID, requests, "error deleting columns")
+}
+
+// Merge merges a range of cells into one. The text of the merged cells is
+// concatenated by the API.
+func (s *Service) Merge(ctx context.Context, presentationID string, tableID string, tableRange *slides.TableRange) error {
+	requests := []*slides.Request{
+		{
+			MergeTableCells: &slides.MergeTableCellsRequest{
+				ObjectId:   tableID,
+				TableRange: tableRange,
+			},
+		},
+	}
+
+	return s.batch(presentationID, requests, "error merging cells")
+}
+
+// Unmerge unmerges every merged cell within a range of cells.
+func (s *Service) Unmerge(ctx context.Context, presentationID string, tableID string, tableRange *slides.TableRange) error {
+	requests := []*slides.Request{
+		{
+			UnmergeTableCells: &slides.UnmergeTableCellsRequest{
+				ObjectId:   tableID,
+				TableRange: tableRange,
+			},
+		},
+	}
+
+	return s.batch(presentationID, requests, "error unmerging cells")
+}
+
+// SetColumnWidth sets the width in PT of columns.
+func (s *Service) SetColumnWidth(ctx context.Context, presentationID string, tableID string, cols []int64, width float64) error {
+	if width < MinColumnWidth {
+		return fmt.Errorf("column width must be at least %g PT", MinColumnWidth)
+	}
+
+	requests := []*slides.Request{
+		{
+			UpdateTableColumnProperties: &slides.UpdateTableColumnPropertiesRequest{
+				ObjectId:      tableID,
+				ColumnIndices: cols,
+				TableColumnProperties: &slides.TableColumnProperties{
+					ColumnWidth: &slides.Dimension{Magnitude: width, Unit: "PT"},
+				},
+				Fields: "columnWidth",
+			},
+		},
+	}
+
+	return s.batch(presentationID, requests, "error setting column width")
+}
+
+// SetRowHeight sets the minimum height in PT of rows. Rows still grow to fit
+// their text.
+func (s *Service) SetRowHeight(ctx context.Context, presentationID string, tableID string, rows []int64, height float64) error {
+	if height <= 0 {
+		return fmt.Errorf("row height must be positive")
+	}
+
+	requests := []*slides.Request{
+		{
+			UpdateTableRowProperties: &slides.UpdateTableRowPropertiesRequest{
+				ObjectId:   tableID,
+				RowIndices: rows,
+				TableRowProperties: &slides.TableRowProperties{
+					MinRowHeight: &slides.Dimension{Magnitude: height, Unit: "PT"},
+				},
+				Fields: "minRowHeight",
+			},
+		},
+	}
+
+	return s.batch(presentationID, requests, "error setting row height")
+}
+
+// batch sends requests in a single batch update.
+func (s *Service) batch(presentationID string, requests []*slides.Request, errPrefix string) error {
+	_, err := s.slidesService.Presentations.BatchUpdate(presentationID, &slides.BatchUpdatePresentationRequest{
+		Requests: requests,
+	}).Do()
+
+	if err != nil {
+		return fmt.Errorf("%s: %w", errPrefix, err)
+	}
+
+	return nil
+}
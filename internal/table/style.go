@@ -0,0 +1,222 @@
+package table
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"google.golang.org/api/slides/v1"
+
+	"google-slide-manager/internal/color"
+	"google-slide-manager/internal/text"
+)
+
+// BorderPositions lists the border positions of UpdateTableBorderProperties.
+var BorderPositions = []string{"ALL", "BOTTOM", "INNER", "INNER_HORIZONTAL", "INNER_VERTICAL", "LEFT", "OUTER", "RIGHT", "TOP"}
+
+// DashStyles lists the border dash styles supported by the Slides API.
+var DashStyles = []string{"SOLID", "DOT", "DASH", "DASH_DOT", "LONG_DASH", "LONG_DASH_DOT"}
+
+// ContentAlignments lists the vertical alignments of cell content.
+var ContentAlignments = []string{"TOP", "MIDDLE", "BOTTOM"}
+
+// Border describes the borders to update. An empty color, zero weight and
+// empty dash style leave the corresponding property untouched.
+type Border struct {
+	Position  string
+	Color     string
+	Weight    float64
+	DashStyle string
+}
+
+// CellStyle lists the cell properties to update. Empty values leave the
+// corresponding property untouched.
+type CellStyle struct {
+	// BackgroundColor accepts any color of color.ParseAlpha, including
+	// transparency.
+	BackgroundColor  string
+	ContentAlignment string
+	Border           Border
+	// Text is applied to the text of every non-empty cell of the range.
+	Text text.TextStyle
+}
+
+// StyleCells applies a style to a range of cells in a single batch.
+func (s *Service) StyleCells(ctx context.Context, presentationID string, tableID string, tableRange *slides.TableRange, style CellStyle) error {
+	presentation, err := s.slidesService.Presentations.Get(presentationID).Do()
+	if err != nil {
+		return fmt.Errorf("error getting presentation: %w", err)
+	}
+
+	table, err := findTable(presentation, tableID)
+	if err != nil {
+		return err
+	}
+
+	if err := checkRange(table, tableRange); err != nil {
+		return err
+	}
+
+	requests, err := styleRequests(table, tableID, tableRange, style)
+	if err != nil {
+		return err
+	}
+
+	if len(requests) == 0 {
+		return fmt.Errorf("no cell style specified")
+	}
+
+	_, err = s.slidesService.Presentations.BatchUpdate(presentationID, &slides.BatchUpdatePresentationRequest{
+		Requests: requests,
+	}).Do()
+
+	if err != nil {
+		return fmt.Errorf("error styling cells: %w", err)
+	}
+
+	return nil
+}
+
+// styleRequests builds the cell property, border and text style requests
+// applying a style to a range of a table.
+func styleRequests(table *slides.Table, tableID string, tableRange *slides.TableRange, style CellStyle) ([]*slides.Request, error) {
+	var requests []*slides.Request
+
+	cellProperties, fields, err := style.cellProperties()
+	if err != nil {
+		return nil, err
+	}
+	if len(fields) > 0 {
+		requests = append(requests, &slides.Request{
+			UpdateTableCellProperties: &slides.UpdateTableCellPropertiesRequest{
+				ObjectId:            tableID,
+				TableRange:          tableRange,
+				TableCellProperties: cellProperties,
+				Fields:              strings.Join(fields, ","),
+			},
+		})
+	}
+
+	borderRequest, err := style.Border.request(tableID, tableRange)
+	if err != nil {
+		return nil, err
+	}
+	if borderRequest != nil {
+		requests = append(requests, borderRequest)
+	}
+
+	if style.Text != (text.TextStyle{}) {
+		textStyle, textFields, err := style.Text.Build()
+		if err != nil {
+			return nil, err
+		}
+
+		row, col := tableRange.Location.RowIndex, tableRange.Location.ColumnIndex
+		for r := row; r < row+tableRange.RowSpan; r++ {
+			for c := col; c < col+tableRange.ColumnSpan; c++ {
+				// Styling the text of an empty cell is rejected by the API.
+				if textLength(table.TableRows[r].TableCells[c].Text) == 0 {
+					continue
+				}
+				requests = append(requests, &slides.Request{
+					UpdateTextStyle: &slides.UpdateTextStyleRequest{
+						ObjectId:     tableID,
+						CellLocation: &slides.TableCellLocation{RowIndex: r, ColumnIndex: c},
+						TextRange:    &slides.Range{Type: "ALL"},
+						Style:        textStyle,
+						Fields:       textFields,
+					},
+				})
+			}
+		}
+	}
+
+	return requests, nil
+}
+
+// cellProperties converts the background and alignment to TableCellProperties
+// and the matching field mask.
+func (cs CellStyle) cellProperties() (*slides.TableCellProperties, []string, error) {
+	properties := &slides.TableCellProperties{}
+	var fields []string
+
+	if cs.BackgroundColor != "" {
+		c, alpha, err := color.ParseAlpha(cs.BackgroundColor)
+		if err != nil {
+			return nil, nil, fmt.Errorf("invalid background color: %w", err)
+		}
+		properties.TableCellBackgroundFill = &slides.TableCellBackgroundFill{
+			SolidFill: &slides.SolidFill{Color: c, Alpha: alpha, ForceSendFields: []string{"Alpha"}},
+		}
+		fields = append(fields, "tableCellBackgroundFill.solidFill.color", "tableCellBackgroundFill.solidFill.alpha")
+	}
+
+	if cs.ContentAlignment != "" {
+		alignment := strings.ToUpper(cs.ContentAlignment)
+		if !slices.Contains(ContentAlignments, alignment) {
+			return nil, nil, fmt.Errorf("invalid content alignment %q (expected one of %s)", cs.ContentAlignment, strings.Join(ContentAlignments, ", "))
+		}
+		properties.ContentAlignment = alignment
+		fields = append(fields, "contentAlignment")
+	}
+
+	return properties, fields, nil
+}
+
+// request builds the UpdateTableBorderProperties request, or nil when no
+// border property is set.
+func (b Border) request(tableID string, tableRange *slides.TableRange) (*slides.Request, error) {
+	properties := &slides.TableBorderProperties{}
+	var fields []string
+
+	if b.Color != "" {
+		c, alpha, err := color.ParseAlpha(b.Color)
+		if err != nil {
+			return nil, fmt.Errorf("invalid border color: %w", err)
+		}
+		properties.TableBorderFill = &slides.TableBorderFill{
+			SolidFill: &slides.SolidFill{Color: c, Alpha: alpha, ForceSendFields: []string{"Alpha"}},
+		}
+		fields = append(fields, "tableBorderFill.solidFill.color", "tableBorderFill.solidFill.alpha")
+	}
+
+	if b.Weight < 0 {
+		return nil, fmt.Errorf("invalid border weight: %g", b.Weight)
+	}
+	if b.Weight > 0 {
+		properties.Weight = &slides.Dimension{Magnitude: b.Weight, Unit: "PT"}
+		fields = append(fields, "weight")
+	}
+
+	if b.DashStyle != "" {
+		dashStyle := strings.ToUpper(b.DashStyle)
+		if !slices.Contains(DashStyles, dashStyle) {
+			return nil, fmt.Errorf("invalid dash style %q (expected one of %s)", b.DashStyle, strings.Join(DashStyles, ", "))
+		}
+		properties.DashStyle = dashStyle
+		fields = append(fields, "dashStyle")
+	}
+
+	if len(fields) == 0 {
+		return nil, nil
+	}
+
+	position := strings.ToUpper(b.Position)
+	if position == "" {
+		position = "ALL"
+	}
+	if !slices.Contains(BorderPositions, position) {
+		return nil, fmt.Errorf("invalid border position %q (expected one of %s)", b.Position, strings.Join(BorderPositions, ", "))
+	}
+
+	return &slides.Request{
+		UpdateTableBorderProperties: &slides.UpdateTableBorderPropertiesRequest{
+			ObjectId:              tableID,
+			TableRange:            tableRange,
+			BorderPosition:        position,
+			TableBorderProperties: properties,
+			Fields:                strings.Join(fields, ","),
+		},
+	}, nil
+}
@@ -0,0 +1,246 @@
+package table
+
+import (
+	"context"
+	"fmt"
+	"io"
+
+	"google.golang.org/api/slides/v1"
+)
+
+// SyncOptions configures Sync.
+type SyncOptions struct {
+	// Highlight sets the background of changed cells when not empty.
+	Highlight string
+	// DryRun computes the report without modifying the table.
+	DryRun bool
+}
+
+// CellChange is a cell whose text differs between the table and the data.
+type CellChange struct {
+	Cell   string `json:"cell"`
+	Row    int64  `json:"row"`
+	Column int64  `json:"column"`
+	Old    string `json:"old"`
+	New    string `json:"new"`
+}
+
+// CellConflict is a cell covered by a merged cell for which the data has
+// text. The API cannot write into covered cells, so its text is not synced.
+type CellConflict struct {
+	Cell     string `json:"cell"`
+	Row      int64  `json:"row"`
+	Column   int64  `json:"column"`
+	MergedBy string `json:"merged_by"`
+	New      string `json:"new"`
+}
+
+// SyncReport describes the changes made by Sync.
+type SyncReport struct {
+	RowsAdded      int64          `json:"rows_added"`
+	RowsRemoved    int64          `json:"rows_removed"`
+	ColumnsAdded   int64          `json:"columns_added"`
+	ColumnsRemoved int64          `json:"columns_removed"`
+	Changes        []CellChange   `json:"changes"`
+	Conflicts      []CellConflict `json:"conflicts"`
+}
+
+// Sync makes a table match data in a single batch: rows and columns are
+// added at the end or removed from the end to match its dimensions, and only
+// the cells whose text differs are rewritten. Cells covered by a merged cell
+// are never written; when the data has text for one, it is reported as a
+// conflict.
+func (s *Service) Sync(ctx context.Context, presentationID string, tableID string, data [][]string, opts SyncOptions) (*SyncReport, error) {
+	presentation, err := s.slidesService.Presentations.Get(presentationID).Do()
+	if err != nil {
+		return nil, fmt.Errorf("error getting presentation: %w", err)
+	}
+
+	table, err := findTable(presentation, tableID)
+	if err != nil {
+		return nil, err
+	}
+
+	rows, cols := Dimensions(data)
+	if rows == 0 || cols == 0 {
+		return nil, fmt.Errorf("data has no cells")
+	}
+
+	report := &SyncReport{}
+	var requests []*slides.Request
+
+	switch {
+	case rows > table.Rows:
+		report.RowsAdded = rows - table.Rows
+		requests = append(requests, &slides.Request{
+			InsertTableRows: &slides.InsertTableRowsRequest{
+				TableObjectId: tableID,
+				CellLocation:  &slides.TableCellLocation{RowIndex: table.Rows - 1},
+				InsertBelow:   true,
+				Number:        report.RowsAdded,
+			},
+		})
+	case rows < table.Rows:
+		report.RowsRemoved = table.Rows - rows
+		for r := table.Rows - 1; r >= rows; r-- {
+			requests = append(requests, &slides.Request{
+				DeleteTableRow: &slides.DeleteTableRowRequest{
+					TableObjectId: tableID,
+					CellLocation:  &slides.TableCellLocation{RowIndex: r},
+				},
+			})
+		}
+	}
+
+	switch {
+	case cols > table.Columns:
+		report.ColumnsAdded = cols - table.Columns
+		requests = append(requests, &slides.Request{
+			InsertTableColumns: &slides.InsertTableColumnsRequest{
+				TableObjectId: tableID,
+				CellLocation:  &slides.TableCellLocation{ColumnIndex: table.Columns - 1},
+				InsertRight:   true,
+				Number:        report.ColumnsAdded,
+			},
+		})
+	case cols < table.Columns:
+		report.ColumnsRemoved = table.Columns - cols
+		for c := table.Columns - 1; c >= cols; c-- {
+			requests = append(requests, &slides.Request{
+				DeleteTableColumn: &slides.DeleteTableColumnRequest{
+					TableObjectId: tableID,
+					CellLocation:  &slides.TableCellLocation{ColumnIndex: c},
+				},
+			})
+		}
+	}
+
+	report.Changes, report.Conflicts = diffCells(table, data, rows, cols)
+	for _, change := range report.Changes {
+		r, c := change.Row, change.Column
+
+		var length int64
+		if r < table.Rows && c < table.Columns {
+			length = textLength(table.TableRows[r].TableCells[c].Text)
+		}
+		requests = append(requests, cellTextRequests(tableID, r, c, length, change.New, ModeReplace)...)
+
+		if opts.Highlight != "" {
+			highlight, err := styleRequests(table, tableID, newRange(r, c, r, c), CellStyle{BackgroundColor: opts.Highlight})
+			if err != nil {
+				return nil, fmt.Errorf("invalid highlight: %w", err)
+			}
+			requests = append(requests, highlight...)
+		}
+	}
+
+	if opts.DryRun || len(requests) == 0 {
+		return report, nil
+	}
+
+	_, err = s.slidesService.Presentations.BatchUpdate(presentationID, &slides.BatchUpdatePresentationRequest{
+		Requests: requests,
+	}).Do()
+
+	if err != nil {
+		return nil, fmt.Errorf("error syncing table: %w", err)
+	}
+
+	return report, nil
+}
+
+// diffCells compares the cells of a table with data resized to rows x cols.
+// Cells beyond the current table read as empty. Cells covered by a merged
+// cell are skipped, or returned as conflicts when the data has text for them.
+func diffCells(table *slides.Table, data [][]string, rows int64, cols int64) ([]CellChange, []CellConflict) {
+	changes := []CellChange{}
+	conflicts := []CellConflict{}
+
+	current := tableData(table, MergedBlank)
+	covered := coveredCells(table)
+	for r := int64(0); r < rows; r++ {
+		for c := int64(0); c < cols; c++ {
+			var oldValue, newValue string
+			if r < table.Rows && c < table.Columns {
+				oldValue = current[r][c]
+			}
+			if r < int64(len(data)) && c < int64(len(data[r])) {
+				newValue = data[r][c]
+			}
+
+			if head, ok := covered[[2]int64{r, c}]; ok {
+				if newValue != "" {
+					conflicts = append(conflicts, CellConflict{
+						Cell:     CellName(r, c),
+						Row:      r,
+						Column:   c,
+						MergedBy: head,
+						New:      newValue,
+					})
+				}
+				continue
+			}
+
+			if oldValue == newValue {
+				continue
+			}
+
+			changes = append(changes, CellChange{
+				Cell:   CellName(r, c),
+				Row:    r,
+				Column: c,
+				Old:    oldValue,
+				New:    newValue,
+			})
+		}
+	}
+
+	return changes, conflicts
+}
+
+// coveredCells maps the location of every cell covered by a merged cell,
+// other than its head, to the name of the head cell.
+func coveredCells(table *slides.Table) map[[2]int64]string {
+	covered := map[[2]int64]string{}
+	for r, row := range table.TableRows {
+		for c, cell := range row.TableCells {
+			for dr := int64(0); dr < max(cell.RowSpan, 1); dr++ {
+				for dc := int64(0); dc < max(cell.ColumnSpan, 1); dc++ {
+					if dr == 0 && dc == 0 {
+						continue
+					}
+					covered[[2]int64{int64(r) + dr, int64(c) + dc}] = CellName(int64(r), int64(c))
+				}
+			}
+		}
+	}
+	return covered
+}
+
+// WriteReport writes a sync report as text, one line per changed cell.
+func WriteReport(w io.Writer, report *SyncReport) error {
+	if report.RowsAdded > 0 {
+		fmt.Fprintf(w, "+ %d row(s)\n", report.RowsAdded)
+	}
+	if report.RowsRemoved > 0 {
+		fmt.Fprintf(w, "- %d row(s)\n", report.RowsRemoved)
+	}
+	if report.ColumnsAdded > 0 {
+		fmt.Fprintf(w, "+ %d column(s)\n", report.ColumnsAdded)
+	}
+	if report.ColumnsRemoved > 0 {
+		fmt.Fprintf(w, "- %d column(s)\n", report.ColumnsRemoved)
+	}
+
+	for _, change := range report.Changes {
+		if _, err := fmt.Fprintf(w, "%s: %q -> %q\n", change.Cell, change.Old, change.New); err != nil {
+			return err
+		}
+	}
+	for _, conflict := range report.Conflicts {
+		if _, err := fmt.Fprintf(w, "! %s: covered by merged cell %s, %q not written\n", conflict.Cell, conflict.MergedBy, conflict.New); err != nil {
+			return err
+		}
+	}
+	return nil
+}
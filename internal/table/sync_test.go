@@ -0,0 +1,121 @@
+package table
+
+import (
+	"reflect"
+	"testing"
+
+	"google.golang.org/api/slides/v1"
+)
+
+// testTable builds a table from cell texts; an empty text leaves the cell
+// without text, as the API returns empty cells.
+func testTable(texts [][]string) *slides.Table {
+	table := &slides.Table{Rows: int64(len(texts))}
+	for r, row := range texts {
+		table.Columns = max(table.Columns, int64(len(row)))
+		tableRow := &slides.TableRow{}
+		for c, value := range row {
+			cell := &slides.TableCell{
+				Location:   &slides.TableCellLocation{RowIndex: int64(r), ColumnIndex: int64(c)},
+				RowSpan:    1,
+				ColumnSpan: 1,
+			}
+			if value != "" {
+				cell.Text = &slides.TextContent{TextElements: []*slides.TextElement{
+					{TextRun: &slides.TextRun{Content: value + "\n"}},
+				}}
+			}
+			tableRow.TableCells = append(tableRow.TableCells, cell)
+		}
+		table.TableRows = append(table.TableRows, tableRow)
+	}
+	return table
+}
+
+func TestDiffCells(t *testing.T) {
+	table := testTable([][]string{
+		{"Name", "Q1"},
+		{"North", "100"},
+	})
+
+	tests := []struct {
+		name string
+		data [][]string
+		want []CellChange
+	}{
+		{
+			name: "unchanged",
+			data: [][]string{{"Name", "Q1"}, {"North", "100"}},
+			want: []CellChange{},
+		},
+		{
+			name: "changed cell",
+			data: [][]string{{"Name", "Q1"}, {"North", "120"}},
+			want: []CellChange{{Cell: "B2", Row: 1, Column: 1, Old: "100", New: "120"}},
+		},
+		{
+			name: "added row and column",
+			data: [][]string{{"Name", "Q1", "Q2"}, {"North", "100", "110"}, {"South", "", ""}},
+			want: []CellChange{
+				{Cell: "C1", Row: 0, Column: 2, Old: "", New: "Q2"},
+				{Cell: "C2", Row: 1, Column: 2, Old: "", New: "110"},
+				{Cell: "A3", Row: 2, Column: 0, Old: "", New: "South"},
+			},
+		},
+		{
+			name: "short row clears cells",
+			data: [][]string{{"Name", "Q1"}, {"North"}},
+			want: []CellChange{{Cell: "B2", Row: 1, Column: 1, Old: "100", New: ""}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rows, cols := Dimensions(tt.data)
+			changes, conflicts := diffCells(table, tt.data, rows, cols)
+			if !reflect.DeepEqual(changes, tt.want) {
+				t.Errorf("changes = %+v, want %+v", changes, tt.want)
+			}
+			if len(conflicts) != 0 {
+				t.Errorf("conflicts = %+v, want none", conflicts)
+			}
+		})
+	}
+}
+
+func TestDiffCellsSkipsMergedCells(t *testing.T) {
+	table := testTable([][]string{
+		{"Region", "", "Total"},
+		{"North", "100", "200"},
+	})
+	// A1 is merged with B1.
+	table.TableRows[0].TableCells[0].ColumnSpan = 2
+
+	data := [][]string{
+		{"Area", "", "Total"},
+		{"North", "100", "250"},
+	}
+	rows, cols := Dimensions(data)
+
+	changes, conflicts := diffCells(table, data, rows, cols)
+	wantChanges := []CellChange{
+		{Cell: "A1", Row: 0, Column: 0, Old: "Region", New: "Area"},
+		{Cell: "C2", Row: 1, Column: 2, Old: "200", New: "250"},
+	}
+	if !reflect.DeepEqual(changes, wantChanges) {
+		t.Errorf("changes = %+v, want %+v", changes, wantChanges)
+	}
+	if len(conflicts) != 0 {
+		t.Errorf("conflicts = %+v, want none", conflicts)
+	}
+
+	data[0][1] = "Q1"
+	changes, conflicts = diffCells(table, data, rows, cols)
+	wantConflicts := []CellConflict{{Cell: "B1", Row: 0, Column: 1, MergedBy: "A1", New: "Q1"}}
+	if !reflect.DeepEqual(conflicts, wantConflicts) {
+		t.Errorf("conflicts = %+v, want %+v", conflicts, wantConflicts)
+	}
+	if !reflect.DeepEqual(changes, wantChanges) {
+		t.Errorf("changes = %+v, want %+v", changes, wantChanges)
+	}
+}
@@ -7,6 +7,9 @@ import (
 	"time"
 
 	"google.golang.org/api/slides/v1"
+
+	"google-slide-manager/internal/geometry"
+	"google-slide-manager/internal/text"
 )
 
 // Service wraps Google Slides service for table operations.
@@ -26,44 +29,130 @@ func generateObjectID(prefix string) string {
 	return fmt.Sprintf("%s_%d", prefix, time.Now().UnixNano())
 }
 
-// Create creates a table on a slide.
-func (s *Service) Create(ctx context.Context, presentationID string, slideIndex int, rows int64, cols int64) (string, error) {
+// DefaultBox is the table placement used when no box is given.
+var DefaultBox = geometry.Box{X: 50, Y: 50, Width: 400, Height: 200}
+
+// Layout constants used to estimate the size of cell contents.
+const (
+	defaultFontSize = 14.0
+	charWidthRatio  = 0.55
+	lineHeightRatio = 1.2
+	cellPadding     = 14.0
+)
+
+// CreateOptions configures table creation.
+type CreateOptions struct {
+	// Box sets the table position and size in PT.
+	Box geometry.Box
+	// Data fills the cells row by row; missing cells stay empty.
+	Data [][]string
+	// Header bolds the first row.
+	Header bool
+	// Fit sizes columns and rows to their content instead of Box.Width and
+	// Box.Height, using an estimate based on the default 14 PT font.
+	Fit bool
+}
+
+// Create creates a table on a slide and fills it with opts.Data in the same
+// batch.
+func (s *Service) Create(ctx context.Context, presentationID string, slideIndex int, rows int64, cols int64, opts CreateOptions) (string, error) {
+	if rows <= 0 || cols <= 0 {
+		return "", fmt.Errorf("rows and columns must be positive")
+	}
+
 	presentation, err := s.slidesService.Presentations.Get(presentationID).Do()
 	if err != nil {
 		return "", fmt.Errorf("error getting presentation: %w", err)
 	}
 
-	if slideIndex >= len(presentation.Slides) {
+	if slideIndex < 0 || slideIndex >= len(presentation.Slides) {
 		return "", fmt.Errorf("slide index out of range")
 	}
 
 	slideID := presentation.Slides[slideIndex].ObjectId
 	tableID := generateObjectID("table")
 
+	box := opts.Box
+	var widths, heights []float64
+	if opts.Fit {
+		widths, heights = fitContent(opts.Data, rows, cols)
+		box.Width, box.Height = sum(widths), sum(heights)
+	}
+
 	requests := []*slides.Request{
 		{
 			CreateTable: &slides.CreateTableRequest{
-				ObjectId: tableID,
-				ElementProperties: &slides.PageElementProperties{
-					PageObjectId: slideID,
-					Size: &slides.Size{
-						Width:  &slides.Dimension{Magnitude: 400, Unit: "PT"},
-						Height: &slides.Dimension{Magnitude: 200, Unit: "PT"},
-					},
-					Transform: &slides.AffineTransform{
-						ScaleX:     1.0,
-						ScaleY:     1.0,
-						TranslateX: 50.0,
-						TranslateY: 50.0,
-						Unit:       "PT",
-					},
-				},
-				Rows:    rows,
-				Columns: cols,
+				ObjectId:          tableID,
+				ElementProperties: geometry.ElementProperties(slideID, box),
+				Rows:              rows,
+				Columns:           cols,
 			},
 		},
 	}
 
+	for col, width := range widths {
+		requests = append(requests, &slides.Request{
+			UpdateTableColumnProperties: &slides.UpdateTableColumnPropertiesRequest{
+				ObjectId:      tableID,
+				ColumnIndices: []int64{int64(col)},
+				TableColumnProperties: &slides.TableColumnProperties{
+					ColumnWidth: &slides.Dimension{Magnitude: width, Unit: "PT"},
+				},
+				Fields: "columnWidth",
+			},
+		})
+	}
+
+	for row, height := range heights {
+		requests = append(requests, &slides.Request{
+			UpdateTableRowProperties: &slides.UpdateTableRowPropertiesRequest{
+				ObjectId:   tableID,
+				RowIndices: []int64{int64(row)},
+				TableRowProperties: &slides.TableRowProperties{
+					MinRowHeight: &slides.Dimension{Magnitude: height, Unit: "PT"},
+				},
+				Fields: "minRowHeight",
+			},
+		})
+	}
+
+	for row, record := range opts.Data {
+		if int64(row) >= rows {
+			break
+		}
+		for col, value := range record {
+			if int64(col) >= cols || value == "" {
+				continue
+			}
+
+			location := &slides.TableCellLocation{
+				RowIndex:    int64(row),
+				ColumnIndex: int64(col),
+			}
+
+			requests = append(requests, &slides.Request{
+				InsertText: &slides.InsertTextRequest{
+					ObjectId:       tableID,
+					CellLocation:   location,
+					Text:           value,
+					InsertionIndex: 0,
+				},
+			})
+
+			if opts.Header && row == 0 {
+				requests = append(requests, &slides.Request{
+					UpdateTextStyle: &slides.UpdateTextStyleRequest{
+						ObjectId:     tableID,
+						CellLocation: location,
+						TextRange:    &slides.Range{Type: "ALL"},
+						Style:        &slides.TextStyle{Bold: true},
+						Fields:       "bold",
+					},
+				})
+			}
+		}
+	}
+
 	_, err = s.slidesService.Presentations.BatchUpdate(presentationID, &slides.BatchUpdatePresentationRequest{
 		Requests: requests,
 	}).Do()
@@ -75,86 +164,122 @@ func (s *Service) Create(ctx context.Context, presentationID string, slideIndex
 	return tableID, nil
 }
 
-// UpdateCell updates a table cell content.
-func (s *Service) UpdateCell(ctx context.Context, presentationID string, tableID string, row int64, col int64, text string) error {
-	requests := []*slides.Request{
-		{
-			InsertText: &slides.InsertTextRequest{
-				ObjectId: tableID,
-				CellLocation: &slides.TableCellLocation{
-					RowIndex:    row,
-					ColumnIndex: col,
-				},
-				Text:           text,
-				InsertionIndex: 0,
-			},
-		},
+// Cell update modes.
+const (
+	ModeReplace = "replace"
+	ModeAppend  = "append"
+	ModePrepend = "prepend"
+)
+
+// UpdateCell sets, appends to or prepends to the text of a table cell.
+func (s *Service) UpdateCell(ctx context.Context, presentationID string, tableID string, row int64, col int64, text string, mode string) error {
+	return s.UpdateCells(ctx, presentationID, tableID, row, col, [][]string{{text}}, mode)
+}
+
+// UpdateCells updates a block of cells starting at (row, col) in a single
+// batch. Each value replaces, is appended to or is prepended to the current
+// cell text depending on mode.
+func (s *Service) UpdateCells(ctx context.Context, presentationID string, tableID string, row int64, col int64, data [][]string, mode string) error {
+	if mode != ModeReplace && mode != ModeAppend && mode != ModePrepend {
+		return fmt.Errorf("invalid update mode %q (expected replace, append or prepend)", mode)
 	}
 
-	_, err := s.slidesService.Presentations.BatchUpdate(presentationID, &slides.BatchUpdatePresentationRequest{
-		Requests: requests,
-	}).Do()
+	presentation, err := s.slidesService.Presentations.Get(presentationID).Do()
+	if err != nil {
+		return fmt.Errorf("error getting presentation: %w", err)
+	}
 
+	table, err := findTable(presentation, tableID)
 	if err != nil {
-		return fmt.Errorf("error updating cell: %w", err)
+		return err
 	}
 
-	return nil
-}
+	var requests []*slides.Request
+	for i, record := range data {
+		for j, value := range record {
+			r, c := row+int64(i), col+int64(j)
+			if r < 0 || r >= table.Rows || c < 0 || c >= table.Columns {
+				return fmt.Errorf("cell (%d, %d) is outside the %dx%d table", r, c, table.Rows, table.Columns)
+			}
 
-// parseColor converts hex color to OpaqueColor.
-func parseColor(hexColor string) *slides.OpaqueColor {
-	hexColor = strings.TrimPrefix(hexColor, "#")
+			length := textLength(table.TableRows[r].TableCells[c].Text)
+			requests = append(requests, cellTextRequests(tableID, r, c, length, value, mode)...)
+		}
+	}
 
-	if len(hexColor) != 6 {
+	if len(requests) == 0 {
 		return nil
 	}
 
-	var r, g, b int
-	fmt.Sscanf(hexColor, "%02x%02x%02x", &r, &g, &b)
+	_, err = s.slidesService.Presentations.BatchUpdate(presentationID, &slides.BatchUpdatePresentationRequest{
+		Requests: requests,
+	}).Do()
 
-	return &slides.OpaqueColor{
-		RgbColor: &slides.RgbColor{
-			Red:   float64(r) / 255.0,
-			Green: float64(g) / 255.0,
-			Blue:  float64(b) / 255.0,
-		},
+	if err != nil {
+		return fmt.Errorf("error updating cells: %w", err)
 	}
+
+	return nil
 }
 
-// StyleCell applies styling to a table cell.
-func (s *Service) StyleCell(ctx context.Context, presentationID string, tableID string, row int64, col int64, bgColor string) error {
-	requests := []*slides.Request{
-		{
-			UpdateTableCellProperties: &slides.UpdateTableCellPropertiesRequest{
-				ObjectId: tableID,
-				TableCellProperties: &slides.TableCellProperties{
-					TableCellBackgroundFill: &slides.TableCellBackgroundFill{
-						SolidFill: &slides.SolidFill{
-							Color: parseColor(bgColor),
-						},
-					},
-				},
-				TableRange: &slides.TableRange{
-					Location: &slides.TableCellLocation{
-						RowIndex:    row,
-						ColumnIndex: col,
-					},
-					RowSpan:    1,
-					ColumnSpan: 1,
+// cellTextRequests builds the requests updating the text of a cell whose
+// current text is length UTF-16 code units long.
+func cellTextRequests(tableID string, row int64, col int64, length int64, value string, mode string) []*slides.Request {
+	location := &slides.TableCellLocation{RowIndex: row, ColumnIndex: col}
+
+	var requests []*slides.Request
+	var index int64
+	switch mode {
+	case ModeReplace:
+		// Deleting the text of an empty cell is rejected by the API.
+		if length > 0 {
+			requests = append(requests, &slides.Request{
+				DeleteText: &slides.DeleteTextRequest{
+					ObjectId:     tableID,
+					CellLocation: location,
+					TextRange:    &slides.Range{Type: "ALL"},
 				},
-				Fields: "tableCellBackgroundFill.solidFill.color",
+			})
+		}
+	case ModeAppend:
+		index = length
+	}
+
+	if value != "" {
+		requests = append(requests, &slides.Request{
+			InsertText: &slides.InsertTextRequest{
+				ObjectId:       tableID,
+				CellLocation:   location,
+				Text:           value,
+				InsertionIndex: index,
 			},
-		},
+		})
 	}
 
-	_, err := s.slidesService.Presentations.BatchUpdate(presentationID, &slides.BatchUpdatePresentationRequest{
-		Requests: requests,
-	}).Do()
+	return requests
+}
 
-	if err != nil {
-		return fmt.Errorf("error styling cell: %w", err)
+// findTable finds a table on the slides of a presentation.
+func findTable(presentation *slides.Presentation, tableID string) (*slides.Table, error) {
+	placement, ok := geometry.Locate(presentation, tableID)
+	if !ok {
+		return nil, fmt.Errorf("table %s not found", tableID)
 	}
 
-	return nil
+	if placement.Element.Table == nil {
+		return nil, fmt.Errorf("element %s is not a table", tableID)
+	}
+
+	return placement.Element.Table, nil
+}
+
+// textLength returns the length in UTF-16 code units of the text of a cell,
+// excluding the trailing newline every non-empty text ends with.
+func textLength(content *slides.TextContent) int64 {
+	return text.UTF16Len(cellText(content))
+}
+
+// cellText returns the text of a cell without its trailing newline.
+func cellText(content *slides.TextContent) string {
+	return strings.TrimSuffix(text.PlainText(content), "\n")
 }
@@ -0,0 +1,276 @@
+package table
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+
+	"google.golang.org/api/slides/v1"
+	"gopkg.in/yaml.v3"
+
+	"google-slide-manager/internal/text"
+)
+
+// Theme describes how to style a whole table. Rules are applied in field
+// order, so later rules override earlier ones where they overlap: font, then
+// banded rows, first column, header and finally borders.
+type Theme struct {
+	// Font applies to the text of every cell.
+	Font *TextRule `yaml:"font"`
+	// BandedRows lists background colors cycled over the rows below the
+	// header (or over all rows when there is no header rule).
+	BandedRows []string `yaml:"banded_rows"`
+	// FirstColumn styles the first column, below the header.
+	FirstColumn *CellRule `yaml:"first_column"`
+	// Header styles the first row.
+	Header  *CellRule    `yaml:"header"`
+	Borders []BorderRule `yaml:"borders"`
+}
+
+// CellRule styles a group of cells.
+type CellRule struct {
+	Background string    `yaml:"background"`
+	Align      string    `yaml:"align"`
+	Text       *TextRule `yaml:"text"`
+}
+
+// TextRule styles the text of a group of cells.
+type TextRule struct {
+	Bold      *bool   `yaml:"bold"`
+	Italic    *bool   `yaml:"italic"`
+	Underline *bool   `yaml:"underline"`
+	Family    string  `yaml:"family"`
+	Size      float64 `yaml:"size"`
+	Color     string  `yaml:"color"`
+}
+
+// BorderRule styles the borders of the whole table.
+type BorderRule struct {
+	Position string  `yaml:"position"`
+	Color    string  `yaml:"color"`
+	Weight   float64 `yaml:"weight"`
+	Dash     string  `yaml:"dash"`
+}
+
+func boolPtr(b bool) *bool {
+	return &b
+}
+
+// Presets are the built-in table themes, used when no style file is given.
+var Presets = map[string]Theme{
+	"zebra": {
+		BandedRows: []string{"#FFFFFF", "#F1F3F4"},
+		Header: &CellRule{
+			Background: "#E8EAED",
+			Text:       &TextRule{Bold: boolPtr(true)},
+		},
+		Borders: []BorderRule{{Position: "ALL", Color: "#DADCE0", Weight: 1}},
+	},
+	"header-dark": {
+		Header: &CellRule{
+			Background: "#202124",
+			Align:      "MIDDLE",
+			Text:       &TextRule{Bold: boolPtr(true), Color: "#FFFFFF"},
+		},
+		Borders: []BorderRule{{Position: "ALL", Color: "#5F6368", Weight: 1}},
+	},
+	"minimal": {
+		BandedRows: []string{"#FFFFFF"},
+		Header: &CellRule{
+			Background: "#FFFFFF",
+			Text:       &TextRule{Bold: boolPtr(true)},
+		},
+		Borders: []BorderRule{
+			{Position: "ALL", Color: "#FFFFFF00", Weight: 1},
+			{Position: "INNER_HORIZONTAL", Color: "#DADCE0", Weight: 1},
+			{Position: "BOTTOM", Color: "#DADCE0", Weight: 1},
+		},
+	},
+	"brand": {
+		Font:       &TextRule{Color: "DARK1"},
+		BandedRows: []string{"LIGHT1", "LIGHT2"},
+		FirstColumn: &CellRule{
+			Text: &TextRule{Bold: boolPtr(true)},
+		},
+		Header: &CellRule{
+			Background: "ACCENT1",
+			Align:      "MIDDLE",
+			Text:       &TextRule{Bold: boolPtr(true), Color: "LIGHT1"},
+		},
+		Borders: []BorderRule{{Position: "ALL", Color: "ACCENT1", Weight: 1}},
+	},
+}
+
+// styleFile is the layout of a YAML style file.
+type styleFile struct {
+	Presets map[string]Theme `yaml:"presets"`
+}
+
+// PresetNames returns the names of the themes of presets in alphabetical order.
+func PresetNames(presets map[string]Theme) []string {
+	names := make([]string, 0, len(presets))
+	for name := range presets {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// Preset returns a theme of presets by name. The name may be empty when
+// presets defines a single theme.
+func Preset(presets map[string]Theme, name string) (Theme, error) {
+	if name == "" {
+		if len(presets) == 1 {
+			for _, theme := range presets {
+				return theme, nil
+			}
+		}
+		return Theme{}, fmt.Errorf("no table style preset given (expected one of %s)", strings.Join(PresetNames(presets), ", "))
+	}
+
+	theme, ok := presets[strings.ToLower(name)]
+	if !ok {
+		return Theme{}, fmt.Errorf("unknown table style preset %q (expected one of %s)", name, strings.Join(PresetNames(presets), ", "))
+	}
+	return theme, nil
+}
+
+// LoadPresets reads the themes defined under the presets key of a YAML style
+// file. Preset names are case-insensitive and unknown keys are rejected.
+func LoadPresets(path string) (map[string]Theme, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("error reading table style file: %w", err)
+	}
+
+	decoder := yaml.NewDecoder(bytes.NewReader(data))
+	decoder.KnownFields(true)
+
+	var file styleFile
+	if err := decoder.Decode(&file); err != nil {
+		return nil, fmt.Errorf("error parsing table style file %s: %w", path, err)
+	}
+
+	if len(file.Presets) == 0 {
+		return nil, fmt.Errorf("table style file %s defines no preset", path)
+	}
+
+	presets := make(map[string]Theme, len(file.Presets))
+	for name, theme := range file.Presets {
+		key := strings.ToLower(name)
+		if _, ok := presets[key]; ok {
+			return nil, fmt.Errorf("table style file %s defines preset %q twice", path, key)
+		}
+		presets[key] = theme
+	}
+
+	return presets, nil
+}
+
+// ApplyTheme styles a whole table with a theme in a single batch.
+func (s *Service) ApplyTheme(ctx context.Context, presentationID string, tableID string, theme Theme) error {
+	presentation, err := s.slidesService.Presentations.Get(presentationID).Do()
+	if err != nil {
+		return fmt.Errorf("error getting presentation: %w", err)
+	}
+
+	table, err := findTable(presentation, tableID)
+	if err != nil {
+		return err
+	}
+
+	var requests []*slides.Request
+	add := func(row, col, rowSpan, colSpan int64, style CellStyle) error {
+		if rowSpan <= 0 || colSpan <= 0 {
+			return nil
+		}
+		tableRange := newRange(row, col, row+rowSpan-1, col+colSpan-1)
+		rangeRequests, err := styleRequests(table, tableID, tableRange, style)
+		if err != nil {
+			return err
+		}
+		requests = append(requests, rangeRequests...)
+		return nil
+	}
+
+	if theme.Font != nil {
+		if err := add(0, 0, table.Rows, table.Columns, CellStyle{Text: theme.Font.textStyle()}); err != nil {
+			return fmt.Errorf("invalid font rule: %w", err)
+		}
+	}
+
+	bodyStart := int64(0)
+	if theme.Header != nil {
+		bodyStart = 1
+	}
+
+	for i := range table.Rows - bodyStart {
+		if len(theme.BandedRows) == 0 {
+			break
+		}
+		band := CellStyle{BackgroundColor: theme.BandedRows[int(i)%len(theme.BandedRows)]}
+		if err := add(bodyStart+i, 0, 1, table.Columns, band); err != nil {
+			return fmt.Errorf("invalid banded rows: %w", err)
+		}
+	}
+
+	if theme.FirstColumn != nil {
+		if err := add(bodyStart, 0, table.Rows-bodyStart, 1, theme.FirstColumn.cellStyle()); err != nil {
+			return fmt.Errorf("invalid first column rule: %w", err)
+		}
+	}
+
+	if theme.Header != nil {
+		if err := add(0, 0, 1, table.Columns, theme.Header.cellStyle()); err != nil {
+			return fmt.Errorf("invalid header rule: %w", err)
+		}
+	}
+
+	for _, border := range theme.Borders {
+		style := CellStyle{Border: Border{Position: border.Position, Color: border.Color, Weight: border.Weight, DashStyle: border.Dash}}
+		if err := add(0, 0, table.Rows, table.Columns, style); err != nil {
+			return fmt.Errorf("invalid border rule: %w", err)
+		}
+	}
+
+	if len(requests) == 0 {
+		return fmt.Errorf("table style defines no rule")
+	}
+
+	_, err = s.slidesService.Presentations.BatchUpdate(presentationID, &slides.BatchUpdatePresentationRequest{
+		Requests: requests,
+	}).Do()
+
+	if err != nil {
+		return fmt.Errorf("error applying table style: %w", err)
+	}
+
+	return nil
+}
+
+// cellStyle converts a cell rule to a CellStyle.
+func (r *CellRule) cellStyle() CellStyle {
+	style := CellStyle{
+		BackgroundColor:  r.Background,
+		ContentAlignment: r.Align,
+	}
+	if r.Text != nil {
+		style.Text = r.Text.textStyle()
+	}
+	return style
+}
+
+// textStyle converts a text rule to a text.TextStyle.
+func (r *TextRule) textStyle() text.TextStyle {
+	return text.TextStyle{
+		Bold:            r.Bold,
+		Italic:          r.Italic,
+		Underline:       r.Underline,
+		FontFamily:      r.Family,
+		FontSize:        r.Size,
+		ForegroundColor: r.Color,
+	}
+}
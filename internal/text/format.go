@@ -0,0 +1,527 @@
+package text
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"google.golang.org/api/slides/v1"
+
+	"google-slide-manager/internal/color"
+)
+
+// Target selects the text of an element to operate on. When neither a range
+// nor a match is given, the whole text of the element is targeted.
+type Target struct {
+	// Cell locates the table cell holding the text; nil for shapes.
+	Cell *slides.TableCellLocation
+	// Start and End delimit a UTF-16 index range. A nil End means "to the end".
+	Start *int64
+	End   *int64
+	// Match targets every case-insensitive occurrence of the given text.
+	Match string
+}
+
+// TextStyle lists the text style properties to update. Nil pointers and empty
+// strings leave the corresponding property untouched.
+type TextStyle struct {
+	Bold            *bool
+	Italic          *bool
+	Underline       *bool
+	Strikethrough   *bool
+	SmallCaps       *bool
+	FontFamily      string
+	FontSize        float64
+	ForegroundColor string
+	BackgroundColor string
+	LinkURL         string
+	BaselineOffset  string
+}
+
+// ParagraphStyle lists the paragraph style properties to update. Nil pointers
+// and empty strings leave the corresponding property untouched. Dimensions
+// are in PT and line spacing is a percentage of normal (100 = single).
+type ParagraphStyle struct {
+	Alignment       string
+	Direction       string
+	LineSpacing     *float64
+	SpaceAbove      *float64
+	SpaceBelow      *float64
+	IndentStart     *float64
+	IndentEnd       *float64
+	IndentFirstLine *float64
+}
+
+var baselineOffsets = []string{"NONE", "SUPERSCRIPT", "SUBSCRIPT"}
+
+var alignments = []string{"START", "CENTER", "END", "JUSTIFIED"}
+
+var directions = []string{"LEFT_TO_RIGHT", "RIGHT_TO_LEFT"}
+
+// BulletPresets lists the bullet glyph presets accepted by CreateParagraphBullets.
+var BulletPresets = []string{
+	"BULLET_DISC_CIRCLE_SQUARE",
+	"BULLET_DIAMONDX_ARROW3D_SQUARE",
+	"BULLET_CHECKBOX",
+	"BULLET_ARROW_DIAMOND_DISC",
+	"BULLET_STAR_CIRCLE_SQUARE",
+	"BULLET_ARROW3D_CIRCLE_SQUARE",
+	"BULLET_LEFTTRIANGLE_DIAMOND_DISC",
+	"BULLET_DIAMONDX_HOLLOWDIAMOND_SQUARE",
+	"BULLET_DIAMOND_CIRCLE_SQUARE",
+	"NUMBERED_DIGIT_ALPHA_ROMAN",
+	"NUMBERED_DIGIT_ALPHA_ROMAN_PARENS",
+	"NUMBERED_DIGIT_NESTED",
+	"NUMBERED_UPPERALPHA_ALPHA_ROMAN",
+	"NUMBERED_UPPERROMAN_UPPERALPHA_DIGIT",
+	"NUMBERED_ZERODIGIT_ALPHA_ROMAN",
+}
+
+// FormatText applies a text style to the targeted text of an element and
+// returns the number of ranges styled.
+func (s *Service) FormatText(ctx context.Context, presentationID string, objectID string, target Target, textStyle TextStyle) (int, error) {
+	style, fields, err := textStyle.Build()
+	if err != nil {
+		return 0, err
+	}
+
+	ranges, err := s.resolveRanges(ctx, presentationID, objectID, target)
+	if err != nil {
+		return 0, err
+	}
+
+	var requests []*slides.Request
+	for _, textRange := range ranges {
+		requests = append(requests, &slides.Request{
+			UpdateTextStyle: &slides.UpdateTextStyleRequest{
+				ObjectId:     objectID,
+				CellLocation: target.Cell,
+				TextRange:    textRange,
+				Style:        style,
+				Fields:       fields,
+			},
+		})
+	}
+
+	_, err = s.slidesService.Presentations.BatchUpdate(presentationID, &slides.BatchUpdatePresentationRequest{
+		Requests: requests,
+	}).Do()
+
+	if err != nil {
+		return 0, fmt.Errorf("error formatting text: %w", err)
+	}
+
+	return len(ranges), nil
+}
+
+// SetText replaces the whole text of a shape or table cell. The existing text
+// is only deleted when there is some, since DeleteText fails on empty elements.
+func (s *Service) SetText(ctx context.Context, presentationID string, objectID string, cell *slides.TableCellLocation, content string) error {
+	current, err := s.elementText(ctx, presentationID, objectID, cell)
+	if err != nil {
+		return err
+	}
+
+	var requests []*slides.Request
+
+	if current != "" {
+		requests = append(requests, &slides.Request{
+			DeleteText: &slides.DeleteTextRequest{
+				ObjectId:     objectID,
+				CellLocation: cell,
+				TextRange:    &slides.Range{Type: "ALL"},
+			},
+		})
+	}
+
+	if content != "" {
+		requests = append(requests, &slides.Request{
+			InsertText: &slides.InsertTextRequest{
+				ObjectId:       objectID,
+				CellLocation:   cell,
+				Text:           content,
+				InsertionIndex: 0,
+			},
+		})
+	}
+
+	if len(requests) == 0 {
+		return nil
+	}
+
+	_, err = s.slidesService.Presentations.BatchUpdate(presentationID, &slides.BatchUpdatePresentationRequest{
+		Requests: requests,
+	}).Do()
+
+	if err != nil {
+		return fmt.Errorf("error setting text: %w", err)
+	}
+
+	return nil
+}
+
+// FormatParagraph applies a paragraph style to every paragraph overlapping
+// the targeted text and returns the number of ranges styled.
+func (s *Service) FormatParagraph(ctx context.Context, presentationID string, objectID string, target Target, paragraphStyle ParagraphStyle) (int, error) {
+	style, fields, err := paragraphStyle.build()
+	if err != nil {
+		return 0, err
+	}
+
+	ranges, err := s.resolveRanges(ctx, presentationID, objectID, target)
+	if err != nil {
+		return 0, err
+	}
+
+	var requests []*slides.Request
+	for _, textRange := range ranges {
+		requests = append(requests, &slides.Request{
+			UpdateParagraphStyle: &slides.UpdateParagraphStyleRequest{
+				ObjectId:     objectID,
+				CellLocation: target.Cell,
+				TextRange:    textRange,
+				Style:        style,
+				Fields:       fields,
+			},
+		})
+	}
+
+	_, err = s.slidesService.Presentations.BatchUpdate(presentationID, &slides.BatchUpdatePresentationRequest{
+		Requests: requests,
+	}).Do()
+
+	if err != nil {
+		return 0, fmt.Errorf("error formatting paragraphs: %w", err)
+	}
+
+	return len(ranges), nil
+}
+
+// AddBullets turns the paragraphs overlapping the targeted text into a list
+// using the given bullet preset.
+func (s *Service) AddBullets(ctx context.Context, presentationID string, objectID string, target Target, preset string) error {
+	preset = strings.ToUpper(preset)
+	if !slices.Contains(BulletPresets, preset) {
+		return fmt.Errorf("invalid bullet preset %q (expected one of %s)", preset, strings.Join(BulletPresets, ", "))
+	}
+
+	ranges, err := s.resolveRanges(ctx, presentationID, objectID, target)
+	if err != nil {
+		return err
+	}
+
+	var requests []*slides.Request
+	for _, textRange := range ranges {
+		requests = append(requests, &slides.Request{
+			CreateParagraphBullets: &slides.CreateParagraphBulletsRequest{
+				ObjectId:     objectID,
+				CellLocation: target.Cell,
+				TextRange:    textRange,
+				BulletPreset: preset,
+			},
+		})
+	}
+
+	_, err = s.slidesService.Presentations.BatchUpdate(presentationID, &slides.BatchUpdatePresentationRequest{
+		Requests: requests,
+	}).Do()
+
+	if err != nil {
+		return fmt.Errorf("error adding bullets: %w", err)
+	}
+
+	return nil
+}
+
+// RemoveBullets removes bullets from the paragraphs overlapping the targeted text.
+func (s *Service) RemoveBullets(ctx context.Context, presentationID string, objectID string, target Target) error {
+	ranges, err := s.resolveRanges(ctx, presentationID, objectID, target)
+	if err != nil {
+		return err
+	}
+
+	var requests []*slides.Request
+	for _, textRange := range ranges {
+		requests = append(requests, &slides.Request{
+			DeleteParagraphBullets: &slides.DeleteParagraphBulletsRequest{
+				ObjectId:     objectID,
+				CellLocation: target.Cell,
+				TextRange:    textRange,
+			},
+		})
+	}
+
+	_, err = s.slidesService.Presentations.BatchUpdate(presentationID, &slides.BatchUpdatePresentationRequest{
+		Requests: requests,
+	}).Do()
+
+	if err != nil {
+		return fmt.Errorf("error removing bullets: %w", err)
+	}
+
+	return nil
+}
+
+// build converts the requested properties to an API ParagraphStyle and its field mask.
+func (ps ParagraphStyle) build() (*slides.ParagraphStyle, string, error) {
+	style := &slides.ParagraphStyle{}
+	var fields []string
+
+	if ps.Alignment != "" {
+		alignment := strings.ToUpper(ps.Alignment)
+		switch alignment {
+		case "LEFT":
+			alignment = "START"
+		case "RIGHT":
+			alignment = "END"
+		case "JUSTIFY":
+			alignment = "JUSTIFIED"
+		}
+		if !slices.Contains(alignments, alignment) {
+			return nil, "", fmt.Errorf("invalid alignment %q (expected one of %s)", ps.Alignment, strings.Join(alignments, ", "))
+		}
+		style.Alignment = alignment
+		fields = append(fields, "alignment")
+	}
+
+	if ps.Direction != "" {
+		direction := strings.ToUpper(ps.Direction)
+		switch direction {
+		case "LTR":
+			direction = "LEFT_TO_RIGHT"
+		case "RTL":
+			direction = "RIGHT_TO_LEFT"
+		}
+		if !slices.Contains(directions, direction) {
+			return nil, "", fmt.Errorf("invalid direction %q (expected one of %s)", ps.Direction, strings.Join(directions, ", "))
+		}
+		style.Direction = direction
+		fields = append(fields, "direction")
+	}
+
+	if ps.LineSpacing != nil {
+		if *ps.LineSpacing <= 0 {
+			return nil, "", fmt.Errorf("invalid line spacing: %g", *ps.LineSpacing)
+		}
+		style.LineSpacing = *ps.LineSpacing
+		fields = append(fields, "lineSpacing")
+	}
+
+	dimensions := []struct {
+		value *float64
+		field string
+		dst   **slides.Dimension
+	}{
+		{ps.SpaceAbove, "spaceAbove", &style.SpaceAbove},
+		{ps.SpaceBelow, "spaceBelow", &style.SpaceBelow},
+		{ps.IndentStart, "indentStart", &style.IndentStart},
+		{ps.IndentEnd, "indentEnd", &style.IndentEnd},
+		{ps.IndentFirstLine, "indentFirstLine", &style.IndentFirstLine},
+	}
+	for _, d := range dimensions {
+		if d.value == nil {
+			continue
+		}
+		if *d.value < 0 {
+			return nil, "", fmt.Errorf("invalid %s: %g", d.field, *d.value)
+		}
+		dim := &slides.Dimension{Magnitude: *d.value, Unit: "PT"}
+		if *d.value == 0 {
+			dim.ForceSendFields = []string{"Magnitude"}
+		}
+		*d.dst = dim
+		fields = append(fields, d.field)
+	}
+
+	if len(fields) == 0 {
+		return nil, "", fmt.Errorf("no paragraph style specified")
+	}
+
+	return style, strings.Join(fields, ","), nil
+}
+
+// Build converts the requested properties to an API TextStyle and its field
+// mask. It fails when no property is set.
+func (ts TextStyle) Build() (*slides.TextStyle, string, error) {
+	style := &slides.TextStyle{}
+	var fields []string
+
+	setBool := func(value *bool, field string, apiField string, dst *bool) {
+		if value == nil {
+			return
+		}
+		*dst = *value
+		fields = append(fields, field)
+		if !*value {
+			style.ForceSendFields = append(style.ForceSendFields, apiField)
+		}
+	}
+
+	setBool(ts.Bold, "bold", "Bold", &style.Bold)
+	setBool(ts.Italic, "italic", "Italic", &style.Italic)
+	setBool(ts.Underline, "underline", "Underline", &style.Underline)
+	setBool(ts.Strikethrough, "strikethrough", "Strikethrough", &style.Strikethrough)
+	setBool(ts.SmallCaps, "smallCaps", "SmallCaps", &style.SmallCaps)
+
+	if ts.FontFamily != "" {
+		style.FontFamily = ts.FontFamily
+		fields = append(fields, "fontFamily")
+	}
+
+	if ts.FontSize < 0 {
+		return nil, "", fmt.Errorf("invalid font size: %g", ts.FontSize)
+	}
+	if ts.FontSize > 0 {
+		style.FontSize = &slides.Dimension{Magnitude: ts.FontSize, Unit: "PT"}
+		fields = append(fields, "fontSize")
+	}
+
+	if ts.ForegroundColor != "" {
+		c, err := color.Parse(ts.ForegroundColor)
+		if err != nil {
+			return nil, "", fmt.Errorf("invalid foreground color: %w", err)
+		}
+		style.ForegroundColor = &slides.OptionalColor{OpaqueColor: c}
+		fields = append(fields, "foregroundColor")
+	}
+
+	if ts.BackgroundColor != "" {
+		c, err := color.Parse(ts.BackgroundColor)
+		if err != nil {
+			return nil, "", fmt.Errorf("invalid background color: %w", err)
+		}
+		style.BackgroundColor = &slides.OptionalColor{OpaqueColor: c}
+		fields = append(fields, "backgroundColor")
+	}
+
+	if ts.LinkURL != "" {
+		style.Link = &slides.Link{Url: ts.LinkURL}
+		fields = append(fields, "link")
+	}
+
+	if ts.BaselineOffset != "" {
+		offset := strings.ToUpper(ts.BaselineOffset)
+		if !slices.Contains(baselineOffsets, offset) {
+			return nil, "", fmt.Errorf("invalid baseline offset %q (expected one of %s)", ts.BaselineOffset, strings.Join(baselineOffsets, ", "))
+		}
+		style.BaselineOffset = offset
+		fields = append(fields, "baselineOffset")
+	}
+
+	if len(fields) == 0 {
+		return nil, "", fmt.Errorf("no text style specified")
+	}
+
+	return style, strings.Join(fields, ","), nil
+}
+
+// resolveRanges converts a target to the API ranges it covers. Match targets
+// require reading the element text to locate every occurrence.
+func (s *Service) resolveRanges(ctx context.Context, presentationID string, objectID string, target Target) ([]*slides.Range, error) {
+	if target.Match == "" {
+		switch {
+		case target.Start == nil && target.End == nil:
+			return []*slides.Range{{Type: "ALL"}}, nil
+		case target.End == nil:
+			return []*slides.Range{{Type: "FROM_START_INDEX", StartIndex: target.Start}}, nil
+		case target.Start == nil:
+			var zero int64
+			return []*slides.Range{{Type: "FIXED_RANGE", StartIndex: &zero, EndIndex: target.End}}, nil
+		case *target.End <= *target.Start:
+			return nil, fmt.Errorf("invalid range %d:%d: end must be greater than start", *target.Start, *target.End)
+		default:
+			return []*slides.Range{{Type: "FIXED_RANGE", StartIndex: target.Start, EndIndex: target.End}}, nil
+		}
+	}
+
+	content, err := s.elementText(ctx, presentationID, objectID, target.Cell)
+	if err != nil {
+		return nil, err
+	}
+
+	re, err := compileQuery(target.Match, false)
+	if err != nil {
+		return nil, err
+	}
+
+	var ranges []*slides.Range
+	for _, loc := range re.FindAllStringIndex(content, -1) {
+		start := UTF16Len(content[:loc[0]])
+		end := UTF16Len(content[:loc[1]])
+		ranges = append(ranges, &slides.Range{Type: "FIXED_RANGE", StartIndex: &start, EndIndex: &end})
+	}
+
+	if len(ranges) == 0 {
+		return nil, fmt.Errorf("text %q not found in %s", target.Match, objectID)
+	}
+
+	return ranges, nil
+}
+
+// elementText returns the text of a shape or table cell.
+func (s *Service) elementText(ctx context.Context, presentationID string, objectID string, cell *slides.TableCellLocation) (string, error) {
+	presentation, err := s.slidesService.Presentations.Get(presentationID).Do()
+	if err != nil {
+		return "", fmt.Errorf("error getting presentation: %w", err)
+	}
+
+	element := FindElement(presentation, objectID)
+	if element == nil {
+		return "", fmt.Errorf("element %s not found", objectID)
+	}
+
+	if cell != nil {
+		if element.Table == nil {
+			return "", fmt.Errorf("element %s is not a table", objectID)
+		}
+		if cell.RowIndex >= int64(len(element.Table.TableRows)) || cell.ColumnIndex >= int64(len(element.Table.TableRows[cell.RowIndex].TableCells)) {
+			return "", fmt.Errorf("cell (%d, %d) out of range", cell.RowIndex, cell.ColumnIndex)
+		}
+		tableCell := element.Table.TableRows[cell.RowIndex].TableCells[cell.ColumnIndex]
+		if tableCell.Text == nil {
+			return "", nil
+		}
+		return PlainText(tableCell.Text), nil
+	}
+
+	if element.Shape == nil {
+		return "", fmt.Errorf("element %s has no text (use --cell for table cells)", objectID)
+	}
+	if element.Shape.Text == nil {
+		return "", nil
+	}
+
+	return PlainText(element.Shape.Text), nil
+}
+
+// FindElement looks up a page element by object ID on every slide and notes
+// page of a presentation, descending into groups.
+func FindElement(presentation *slides.Presentation, objectID string) *slides.PageElement {
+	for _, slide := range presentation.Slides {
+		if element := findInElements(slide.PageElements, objectID); element != nil {
+			return element
+		}
+		if slide.SlideProperties != nil && slide.SlideProperties.NotesPage != nil {
+			if element := findInElements(slide.SlideProperties.NotesPage.PageElements, objectID); element != nil {
+				return element
+			}
+		}
+	}
+	return nil
+}
+
+// findInElements searches page elements and their group children by object ID.
+func findInElements(elements []*slides.PageElement, objectID string) *slides.PageElement {
+	for _, element := range elements {
+		if element.ObjectId == objectID {
+			return element
+		}
+		if element.ElementGroup != nil {
+			if found := findInElements(element.ElementGroup.Children, objectID); found != nil {
+				return found
+			}
+		}
+	}
+	return nil
+}
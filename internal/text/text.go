@@ -3,7 +3,9 @@ package text
 import (
 	"context"
 	"fmt"
+	"regexp"
 	"strings"
+	"sync"
 
 	"google.golang.org/api/slides/v1"
 )
@@ -13,11 +15,37 @@ type Service struct {
 	slidesService *slides.Service
 }
 
-// SearchResult represents a text search result.
+// Kinds of text containers reported in search results.
+const (
+	KindShape       = "shape"
+	KindPlaceholder = "placeholder"
+	KindTableCell   = "table_cell"
+	KindNotes       = "notes"
+)
+
+// contextRunes is the number of runes shown on each side of a match.
+const contextRunes = 30
+
+// SearchOptions controls how Search matches text.
+type SearchOptions struct {
+	Regex        bool
+	IncludeNotes bool
+}
+
+// SearchResult represents a text search match. StartIndex and EndIndex are
+// UTF-16 offsets into the element text and can be used as a FIXED_RANGE.
 type SearchResult struct {
-	SlideIndex int    `json:"slide_index"`
-	ObjectID   string `json:"object_id"`
-	Text       string `json:"text"`
+	SlideIndex      int    `json:"slide_index"`
+	SlideID         string `json:"slide_id"`
+	ObjectID        string `json:"object_id"`
+	Kind            string `json:"kind"`
+	PlaceholderType string `json:"placeholder_type,omitempty"`
+	Row             *int64 `json:"row,omitempty"`
+	Col             *int64 `json:"col,omitempty"`
+	StartIndex      int64  `json:"start_index"`
+	EndIndex        int64  `json:"end_index"`
+	Text            string `json:"text"`
+	Context         string `json:"context"`
 }
 
 // NewService creates a new text service.
@@ -78,32 +106,280 @@ func (s *Service) Replace(ctx context.Context, presentationID string, findText s
 	return nil
 }
 
-// Search searches for text in a presentation and returns matches.
-func (s *Service) Search(ctx context.Context, presentationID string, query string) ([]SearchResult, error) {
+// PresentationMatches groups the search results of one presentation.
+type PresentationMatches struct {
+	PresentationID string         `json:"presentation_id"`
+	Title          string         `json:"title"`
+	URL            string         `json:"url"`
+	Matches        []SearchResult `json:"matches,omitempty"`
+	Error          string         `json:"error,omitempty"`
+}
+
+// Search searches for text in a presentation and returns every match with its
+// position. Matching runs over the concatenated text of each shape, table cell
+// and (optionally) speaker notes body, so matches spanning several text runs
+// are found. Plain queries are case-insensitive; regex queries are used as-is.
+func (s *Service) Search(ctx context.Context, presentationID string, query string, opts SearchOptions) ([]SearchResult, error) {
+	re, err := compileQuery(query, opts.Regex)
+	if err != nil {
+		return nil, err
+	}
+
 	presentation, err := s.slidesService.Presentations.Get(presentationID).Do()
 	if err != nil {
 		return nil, fmt.Errorf("error getting presentation: %w", err)
 	}
 
+	return searchPresentation(presentation, re, opts.IncludeNotes), nil
+}
+
+// SearchPresentations searches several presentations in parallel, running at
+// most concurrency requests at once. Only presentations with matches or errors
+// are returned, in the order of presentationIDs. A presentation that cannot be
+// read is reported through its Error field rather than aborting the search;
+// its Title is then taken from names, keyed by presentation ID, which may be
+// nil.
+func (s *Service) SearchPresentations(ctx context.Context, presentationIDs []string, names map[string]string, query string, opts SearchOptions, concurrency int) ([]PresentationMatches, error) {
+	re, err := compileQuery(query, opts.Regex)
+	if err != nil {
+		return nil, err
+	}
+
+	if concurrency < 1 {
+		concurrency = 1
+	}
+
+	all := make([]PresentationMatches, len(presentationIDs))
+	sem := make(chan struct{}, concurrency)
+	var wg sync.WaitGroup
+
+	for i, presentationID := range presentationIDs {
+		wg.Add(1)
+		go func(i int, presentationID string) {
+			defer wg.Done()
+			sem <- struct{}{}
+			defer func() { <-sem }()
+
+			result := PresentationMatches{
+				PresentationID: presentationID,
+				URL:            fmt.Sprintf("https://docs.google.com/presentation/d/%s/edit", presentationID),
+			}
+
+			presentation, err := s.slidesService.Presentations.Get(presentationID).Context(ctx).Do()
+			if err != nil {
+				result.Error = fmt.Sprintf("error getting presentation: %v", err)
+				result.Title = names[presentationID]
+			} else {
+				result.Title = presentation.Title
+				result.Matches = searchPresentation(presentation, re, opts.IncludeNotes)
+			}
+
+			all[i] = result
+		}(i, presentationID)
+	}
+
+	wg.Wait()
+
+	var results []PresentationMatches
+	for _, result := range all {
+		if len(result.Matches) > 0 || result.Error != "" {
+			results = append(results, result)
+		}
+	}
+
+	return results, nil
+}
+
+// compileQuery builds the regular expression used to match a search query.
+func compileQuery(query string, isRegex bool) (*regexp.Regexp, error) {
+	if query == "" {
+		return nil, fmt.Errorf("search query is empty")
+	}
+
+	if !isRegex {
+		return regexp.MustCompile("(?i)" + regexp.QuoteMeta(query)), nil
+	}
+
+	re, err := regexp.Compile(query)
+	if err != nil {
+		return nil, fmt.Errorf("invalid regex: %w", err)
+	}
+	return re, nil
+}
+
+// searchPresentation matches re against every text block of a presentation.
+func searchPresentation(presentation *slides.Presentation, re *regexp.Regexp, includeNotes bool) []SearchResult {
 	var results []SearchResult
 
+	for _, block := range collectBlocks(presentation, includeNotes) {
+		for _, loc := range re.FindAllStringIndex(block.content, -1) {
+			if loc[0] == loc[1] {
+				continue
+			}
+
+			results = append(results, SearchResult{
+				SlideIndex:      block.slideIndex,
+				SlideID:         block.slideID,
+				ObjectID:        block.objectID,
+				Kind:            block.kind,
+				PlaceholderType: block.placeholderType,
+				Row:             block.row,
+				Col:             block.col,
+				StartIndex:      UTF16Len(block.content[:loc[0]]),
+				EndIndex:        UTF16Len(block.content[:loc[1]]),
+				Text:            block.content[loc[0]:loc[1]],
+				Context:         matchContext(block.content, loc[0], loc[1]),
+			})
+		}
+	}
+
+	return results
+}
+
+// textBlock is the concatenated text of one shape, table cell or notes body.
+type textBlock struct {
+	slideIndex      int
+	slideID         string
+	objectID        string
+	kind            string
+	placeholderType string
+	row             *int64
+	col             *int64
+	content         string
+}
+
+// collectBlocks gathers every text block of a presentation in slide order.
+func collectBlocks(presentation *slides.Presentation, includeNotes bool) []textBlock {
+	var blocks []textBlock
+
 	for slideIdx, slide := range presentation.Slides {
-		for _, element := range slide.PageElements {
-			if element.Shape != nil && element.Shape.Text != nil {
-				for _, textElement := range element.Shape.Text.TextElements {
-					if textElement.TextRun != nil {
-						if strings.Contains(strings.ToLower(textElement.TextRun.Content), strings.ToLower(query)) {
-							results = append(results, SearchResult{
-								SlideIndex: slideIdx,
-								ObjectID:   element.ObjectId,
-								Text:       textElement.TextRun.Content,
-							})
-						}
+		base := textBlock{slideIndex: slideIdx, slideID: slide.ObjectId}
+		blocks = appendElementBlocks(blocks, base, slide.PageElements)
+
+		if !includeNotes || slide.SlideProperties == nil || slide.SlideProperties.NotesPage == nil {
+			continue
+		}
+
+		notesPage := slide.SlideProperties.NotesPage
+		if notesPage.NotesProperties == nil {
+			continue
+		}
+
+		speakerNotesID := notesPage.NotesProperties.SpeakerNotesObjectId
+		for _, element := range notesPage.PageElements {
+			if element.ObjectId == speakerNotesID && element.Shape != nil && element.Shape.Text != nil {
+				block := base
+				block.objectID = element.ObjectId
+				block.kind = KindNotes
+				block.content = PlainText(element.Shape.Text)
+				blocks = append(blocks, block)
+			}
+		}
+	}
+
+	return blocks
+}
+
+// appendElementBlocks appends the text blocks of page elements, descending into groups.
+func appendElementBlocks(blocks []textBlock, base textBlock, elements []*slides.PageElement) []textBlock {
+	for _, element := range elements {
+		switch {
+		case element.ElementGroup != nil:
+			blocks = appendElementBlocks(blocks, base, element.ElementGroup.Children)
+
+		case element.Shape != nil && element.Shape.Text != nil:
+			block := base
+			block.objectID = element.ObjectId
+			block.kind = KindShape
+			if element.Shape.Placeholder != nil {
+				block.kind = KindPlaceholder
+				block.placeholderType = element.Shape.Placeholder.Type
+			}
+			block.content = PlainText(element.Shape.Text)
+			blocks = append(blocks, block)
+
+		case element.Table != nil:
+			for rowIdx, row := range element.Table.TableRows {
+				for colIdx, cell := range row.TableCells {
+					if cell.Text == nil {
+						continue
 					}
+					r, c := int64(rowIdx), int64(colIdx)
+					block := base
+					block.objectID = element.ObjectId
+					block.kind = KindTableCell
+					block.row = &r
+					block.col = &c
+					block.content = PlainText(cell.Text)
+					blocks = append(blocks, block)
 				}
 			}
 		}
 	}
 
-	return results, nil
+	return blocks
+}
+
+// PlainText joins the text runs and auto text of a text content in index
+// order. A nil content is empty.
+func PlainText(content *slides.TextContent) string {
+	if content == nil {
+		return ""
+	}
+
+	var sb strings.Builder
+	for _, textElement := range content.TextElements {
+		switch {
+		case textElement.TextRun != nil:
+			sb.WriteString(textElement.TextRun.Content)
+		case textElement.AutoText != nil:
+			sb.WriteString(textElement.AutoText.Content)
+		}
+	}
+	return sb.String()
+}
+
+// SlideTitle returns the text of the title placeholder of a slide on a single
+// line, or an empty string when the slide has no title.
+func SlideTitle(slide *slides.Page) string {
+	for _, element := range slide.PageElements {
+		shape := element.Shape
+		if shape == nil || shape.Placeholder == nil || shape.Text == nil {
+			continue
+		}
+		if shape.Placeholder.Type != "TITLE" && shape.Placeholder.Type != "CENTERED_TITLE" {
+			continue
+		}
+		return strings.Join(strings.Fields(PlainText(shape.Text)), " ")
+	}
+	return ""
+}
+
+// UTF16Len returns the length of s in UTF-16 code units, the unit of Slides text indices.
+func UTF16Len(s string) int64 {
+	var n int64
+	for _, r := range s {
+		if r >= 0x10000 {
+			n += 2
+		} else {
+			n++
+		}
+	}
+	return n
+}
+
+// matchContext returns the match surrounded by up to contextRunes runes on each side.
+func matchContext(content string, start int, end int) string {
+	before := []rune(content[:start])
+	if len(before) > contextRunes {
+		before = before[len(before)-contextRunes:]
+	}
+
+	after := []rune(content[end:])
+	if len(after) > contextRunes {
+		after = after[:contextRunes]
+	}
+
+	snippet := string(before) + content[start:end] + string(after)
+	return strings.TrimSpace(strings.ReplaceAll(snippet, "\n", " "))
 }
@@ -0,0 +1,120 @@
+package text
+
+import (
+	"strings"
+	"testing"
+
+	"google.golang.org/api/slides/v1"
+)
+
+func TestCompileQuery(t *testing.T) {
+	tests := []struct {
+		name    string
+		query   string
+		regex   bool
+		input   string
+		want    []string
+		wantErr bool
+	}{
+		{name: "literal is case-insensitive", query: "total", input: "Total and TOTAL", want: []string{"Total", "TOTAL"}},
+		{name: "literal quotes metacharacters", query: "a.b", input: "a.b axb", want: []string{"a.b"}},
+		{name: "regex", query: `Q[1-4]`, regex: true, input: "Q1 Q5 Q4", want: []string{"Q1", "Q4"}},
+		{name: "regex is case-sensitive", query: `q1`, regex: true, input: "Q1", want: nil},
+		{name: "invalid regex", query: `(`, regex: true, wantErr: true},
+		{name: "empty query", query: "", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			re, err := compileQuery(tt.query, tt.regex)
+			if tt.wantErr {
+				if err == nil {
+					t.Fatalf("compileQuery(%q) succeeded, want error", tt.query)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("compileQuery(%q): %v", tt.query, err)
+			}
+
+			got := re.FindAllString(tt.input, -1)
+			if strings.Join(got, "|") != strings.Join(tt.want, "|") {
+				t.Errorf("matches = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestMatchContext(t *testing.T) {
+	long := strings.Repeat("x", 40)
+
+	tests := []struct {
+		name    string
+		content string
+		match   string
+		want    string
+	}{
+		{name: "short text", content: "the total is 42", match: "total", want: "the total is 42"},
+		{name: "newlines become spaces", content: "first\nsecond\nthird", match: "second", want: "first second third"},
+		{name: "long text is cut", content: long + "match" + long, match: "match", want: strings.Repeat("x", 30) + "match" + strings.Repeat("x", 30)},
+		{name: "context counts runes", content: strings.Repeat("é", 40) + "match", match: "match", want: strings.Repeat("é", 30) + "match"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			start := strings.Index(tt.content, tt.match)
+			got := matchContext(tt.content, start, start+len(tt.match))
+			if got != tt.want {
+				t.Errorf("matchContext = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSearchPresentationUTF16Offsets(t *testing.T) {
+	presentation := &slides.Presentation{
+		Slides: []*slides.Page{{
+			ObjectId: "slide1",
+			PageElements: []*slides.PageElement{{
+				ObjectId: "shape1",
+				Shape: &slides.Shape{Text: &slides.TextContent{TextElements: []*slides.TextElement{
+					{TextRun: &slides.TextRun{Content: "😀 café total\n"}},
+				}}},
+			}},
+		}},
+	}
+
+	re, err := compileQuery("total", false)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	results := searchPresentation(presentation, re, false)
+	if len(results) != 1 {
+		t.Fatalf("got %d results, want 1", len(results))
+	}
+
+	// The emoji takes two UTF-16 code units and é one.
+	if results[0].StartIndex != 8 || results[0].EndIndex != 13 {
+		t.Errorf("range = [%d, %d), want [8, 13)", results[0].StartIndex, results[0].EndIndex)
+	}
+	if results[0].ObjectID != "shape1" || results[0].Kind != KindShape {
+		t.Errorf("result = %+v, want shape1 of kind %s", results[0], KindShape)
+	}
+}
+
+func TestUTF16Len(t *testing.T) {
+	tests := map[string]int64{
+		"":      0,
+		"abc":   3,
+		"café":  4,
+		"😀":     2,
+		"a😀b\n": 5,
+	}
+
+	for input, want := range tests {
+		if got := UTF16Len(input); got != want {
+			t.Errorf("UTF16Len(%q) = %d, want %d", input, got, want)
+		}
+	}
+}